@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	mapping "github.com/gogo-framework/db/internal/mapping"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// tablePtr is a pointer to a table type that can be instantiated with schema.NewTable
+type tablePtr[T any] interface {
+	*T
+	schema.Table
+	schema.TableConfigurer
+}
+
+// Collect executes the statement and maps every row into a new instance of the table type T.
+// The result columns must be fields of model, they are bound to the same fields of every new instance,
+// so the returned instances hold independent values and model itself isn't modified.
+func Collect[T any, PT tablePtr[T]](ctx context.Context, db *DB, stmt ResultStmt, model PT) ([]PT, error) {
+	binder, err := mapping.NewBinder(model, stmt.ResultColumns())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []PT
+	for rows.Next() {
+		instance := schema.NewTable[T, PT]()
+		if err := rows.scanInto(binder.Bind(instance)); err != nil {
+			return nil, err
+		}
+		result = append(result, instance)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// One executes the statement and maps its only row into a new instance of the table type T, see Collect.
+// If there are no rows sql.ErrNoRows is returned, if there is more than one row an error is returned.
+func One[T any, PT tablePtr[T]](ctx context.Context, db *DB, stmt ResultStmt, model PT) (PT, error) {
+	binder, err := mapping.NewBinder(model, stmt.ResultColumns())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, sql.ErrNoRows
+	}
+	instance := schema.NewTable[T, PT]()
+	if err := rows.scanInto(binder.Bind(instance)); err != nil {
+		return nil, err
+	}
+	if rows.Next() {
+		return nil, fmt.Errorf("multiple rows returned for single result query")
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
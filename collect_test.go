@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo-framework/db/dialect/sqlite"
+)
+
+func TestCollect(t *testing.T) {
+	db, mock := newTestDB(t)
+	mock.ExpectQuery(selectUsers).
+		WithArgs(int64(0)).
+		WillReturnRows(userRows().AddRow(int64(1), "alice").AddRow(int64(2), "bob")).
+		RowsWillBeClosed()
+
+	model := sqlite.NewTable[testUser]()
+	users, err := Collect(context.Background(), db, selectUsersStmt(model), model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 2 {
+		t.Fatalf("got %d users, want 2", len(users))
+	}
+
+	// Every row is mapped into its own instance, the values of the first row aren't overwritten by the second
+	if users[0] == users[1] || users[0] == model {
+		t.Fatal("expected a new instance for every row")
+	}
+	if users[0].ID.Get() != 1 || users[0].Name.Get() != "alice" {
+		t.Errorf("got %d %q for the first row, want 1 \"alice\"", users[0].ID.Get(), users[0].Name.Get())
+	}
+	if users[1].ID.Get() != 2 || users[1].Name.Get() != "bob" {
+		t.Errorf("got %d %q for the second row, want 2 \"bob\"", users[1].ID.Get(), users[1].Name.Get())
+	}
+	if model.ID.IsScanned() || model.Name.IsScanned() {
+		t.Error("expected the model not to be scanned")
+	}
+}
+
+func TestCollectNoRows(t *testing.T) {
+	db, mock := newTestDB(t)
+	mock.ExpectQuery(selectUsers).
+		WithArgs(int64(0)).
+		WillReturnRows(userRows()).
+		RowsWillBeClosed()
+
+	model := sqlite.NewTable[testUser]()
+	users, err := Collect(context.Background(), db, selectUsersStmt(model), model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 0 {
+		t.Errorf("got %d users, want none", len(users))
+	}
+}
@@ -0,0 +1,195 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+
+	"github.com/gogo-framework/db/dialect"
+	mapping "github.com/gogo-framework/db/internal/mapping"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// Conn is the part of database/sql that is needed to execute statements.
+// It's implemented by *sql.DB, *sql.Tx and *sql.Conn.
+type Conn interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Stmt is a statement built by a dialect package, e.g. sqlite.Select or sqlite.Update
+type Stmt interface {
+	WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error)
+}
+
+// ResultStmt is a statement that returns rows, e.g. a SELECT statement or a statement with a RETURNING clause.
+// The rows are scanned into the result columns, which are bound to the fields of a table or result struct.
+type ResultStmt interface {
+	Stmt
+	ResultColumns() []schema.Column
+}
+
+// MappingError is returned by queries of a strict DB when the result set doesn't match the selected columns.
+// It lists the missing, extra and duplicate column names.
+type MappingError = mapping.MappingError
+
+// DB executes statements on a database connection, transaction or single connection using a dialect
+type DB struct {
+	conn    Conn
+	dialect dialect.Dialect
+	strict  bool
+}
+
+// New creates a DB that executes statements on conn, the statements are written for the given dialect
+func New(conn Conn, d dialect.Dialect) *DB {
+	return &DB{
+		conn:    conn,
+		dialect: d,
+	}
+}
+
+// Strict returns a copy of the DB that maps results in strict mode.
+// Queries then fail when a selected column is missing from the result set, when the result set has columns
+// that aren't selected or when a column name is ambiguous, instead of leaving columns with zero values.
+// This catches differences between the code and the database schema, e.g. in tests.
+func (db *DB) Strict() *DB {
+	strict := *db
+	strict.strict = true
+	return &strict
+}
+
+// Conn returns the underlying connection
+func (db *DB) Conn() Conn {
+	return db.conn
+}
+
+// Dialect returns the dialect statements are written for
+func (db *DB) Dialect() dialect.Dialect {
+	return db.dialect
+}
+
+// Render writes the statement with the dialect of the DB and returns the SQL and its arguments
+func (db *DB) Render(ctx context.Context, stmt Stmt) (string, []any, error) {
+	w := &bytes.Buffer{}
+	args, err := stmt.WriteSql(ctx, w, db.dialect, 1)
+	if err != nil {
+		return "", nil, fmt.Errorf("error writing statement: %w", err)
+	}
+	return w.String(), args, nil
+}
+
+// Exec executes a statement that doesn't return rows, e.g. an INSERT, UPDATE or DELETE statement
+func (db *DB) Exec(ctx context.Context, stmt Stmt) (sql.Result, error) {
+	query, args, err := db.Render(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+	return db.conn.ExecContext(ctx, query, args...)
+}
+
+// Query executes the statement and returns its rows, every call to Rows.Scan scans the current row into the result columns.
+// The rows must be closed when done.
+func (db *DB) Query(ctx context.Context, stmt ResultStmt) (*Rows, error) {
+	columns := stmt.ResultColumns()
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("statement has no result columns")
+	}
+
+	query, args, err := db.Render(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	mapper := mapping.NewRowMapper(columns)
+	if db.strict {
+		mapper.Strict()
+	}
+	// The result columns are matched before the first row, so a mismatch is also reported when there are no rows
+	if err := mapper.Prepare(rows); err != nil {
+		rows.Close()
+		return nil, err
+	}
+	return &Rows{
+		rows:   rows,
+		mapper: mapper,
+	}, nil
+}
+
+// QueryRow executes the statement and scans the first row into the result columns, other rows are discarded.
+// If there are no rows sql.ErrNoRows is returned.
+func (db *DB) QueryRow(ctx context.Context, stmt ResultStmt) error {
+	rows, err := db.Query(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return sql.ErrNoRows
+	}
+	return rows.Scan()
+}
+
+// QueryOne executes the statement and scans its only row into the result columns.
+// If there are no rows sql.ErrNoRows is returned, if there is more than one row an error is returned.
+func (db *DB) QueryOne(ctx context.Context, stmt ResultStmt) error {
+	rows, err := db.Query(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return sql.ErrNoRows
+	}
+	if err := rows.Scan(); err != nil {
+		return err
+	}
+	if rows.Next() {
+		return fmt.Errorf("multiple rows returned for single result query")
+	}
+	return rows.Err()
+}
+
+// Rows is the result of a query, the current row is scanned into the result columns of the statement
+type Rows struct {
+	rows   *sql.Rows
+	mapper *mapping.RowMapper
+}
+
+// Next prepares the next row for scanning, it returns false when there are no more rows or an error occurred
+func (r *Rows) Next() bool {
+	return r.rows.Next()
+}
+
+// Scan scans the current row into the result columns
+func (r *Rows) Scan() error {
+	return r.mapper.MapRow(r.rows)
+}
+
+// scanInto scans the current row into dest, which holds the fields of another instance for every result column
+func (r *Rows) scanInto(dest []schema.Column) error {
+	return r.mapper.MapRowInto(r.rows, dest)
+}
+
+// Err returns the error, if any, that was encountered during iteration
+func (r *Rows) Err() error {
+	return r.rows.Err()
+}
+
+// Close closes the rows, it's safe to call Close multiple times
+func (r *Rows) Close() error {
+	return r.rows.Close()
+}
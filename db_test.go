@@ -0,0 +1,179 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gogo-framework/db/dialect/sqlite"
+)
+
+type testUser struct {
+	sqlite.BaseTable
+	ID   sqlite.Integer
+	Name sqlite.Text
+}
+
+func (u *testUser) ConfigureSchema(ts *sqlite.TableSchema) {
+	ts.SetName("users")
+	ts.RegisterColumn("id", &u.ID)
+	ts.RegisterColumn("name", &u.Name)
+}
+
+const selectUsers = `SELECT "users"."id", "users"."name" FROM "users" WHERE "users"."id" > ?`
+
+// newTestDB returns a DB backed by sqlmock, queries are matched exactly and all expectations must be met
+func newTestDB(t *testing.T) (*DB, sqlmock.Sqlmock) {
+	t.Helper()
+	conn, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+		conn.Close()
+	})
+	return New(conn, &sqlite.SqliteDialect{}), mock
+}
+
+func selectUsersStmt(u *testUser) *sqlite.SelectStmt {
+	return sqlite.Select(&u.ID, &u.Name, sqlite.From(u), sqlite.Where(u.ID.Gt(0)))
+}
+
+func userRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "name"})
+}
+
+func TestQuery(t *testing.T) {
+	db, mock := newTestDB(t)
+	mock.ExpectQuery(selectUsers).
+		WithArgs(int64(0)).
+		WillReturnRows(userRows().AddRow(int64(1), "alice").AddRow(int64(2), "bob")).
+		RowsWillBeClosed()
+
+	u := sqlite.NewTable[testUser]()
+	rows, err := db.Query(context.Background(), selectUsersStmt(u))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		if err := rows.Scan(); err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, u.Name.Get())
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 2 || names[0] != "alice" || names[1] != "bob" {
+		t.Errorf("got names %v, want [alice bob]", names)
+	}
+	if u.ID.Get() != 2 {
+		t.Errorf("got id %d after the last row, want 2", u.ID.Get())
+	}
+}
+
+func TestQueryRenderError(t *testing.T) {
+	db, _ := newTestDB(t)
+
+	// An update without WHERE is rejected before anything is sent to the connection
+	u := sqlite.NewTable[testUser]()
+	stmt := sqlite.Update(u, sqlite.Set(&u.Name, "x"), sqlite.Returning(&u.ID))
+	if _, err := db.Query(context.Background(), stmt); err == nil {
+		t.Error("expected an error for an unscoped update")
+	}
+}
+
+func TestQueryOne(t *testing.T) {
+	t.Run("one row", func(t *testing.T) {
+		db, mock := newTestDB(t)
+		mock.ExpectQuery(selectUsers).
+			WithArgs(int64(0)).
+			WillReturnRows(userRows().AddRow(int64(1), "alice")).
+			RowsWillBeClosed()
+
+		u := sqlite.NewTable[testUser]()
+		if err := db.QueryOne(context.Background(), selectUsersStmt(u)); err != nil {
+			t.Fatal(err)
+		}
+		if u.ID.Get() != 1 || u.Name.Get() != "alice" {
+			t.Errorf("got %d %q, want 1 \"alice\"", u.ID.Get(), u.Name.Get())
+		}
+	})
+
+	t.Run("no rows", func(t *testing.T) {
+		db, mock := newTestDB(t)
+		mock.ExpectQuery(selectUsers).
+			WithArgs(int64(0)).
+			WillReturnRows(userRows()).
+			RowsWillBeClosed()
+
+		u := sqlite.NewTable[testUser]()
+		if err := db.QueryOne(context.Background(), selectUsersStmt(u)); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("got error %v, want sql.ErrNoRows", err)
+		}
+	})
+
+	t.Run("multiple rows", func(t *testing.T) {
+		db, mock := newTestDB(t)
+		mock.ExpectQuery(selectUsers).
+			WithArgs(int64(0)).
+			WillReturnRows(userRows().AddRow(int64(1), "alice").AddRow(int64(2), "bob")).
+			RowsWillBeClosed()
+
+		u := sqlite.NewTable[testUser]()
+		if err := db.QueryOne(context.Background(), selectUsersStmt(u)); err == nil {
+			t.Error("expected an error for multiple rows")
+		}
+	})
+}
+
+func TestExec(t *testing.T) {
+	db, mock := newTestDB(t)
+	mock.ExpectExec(`UPDATE "users" SET "name" = ? WHERE "users"."id" = ?`).
+		WithArgs("carol", int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	u := sqlite.NewTable[testUser]()
+	result, err := db.Exec(context.Background(), sqlite.Update(u, sqlite.Set(&u.Name, "carol"), sqlite.Where(u.ID.Eq(3))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if affected != 1 {
+		t.Errorf("got %d affected rows, want 1", affected)
+	}
+}
+
+func TestQueryOneUnregisteredResult(t *testing.T) {
+	db, mock := newTestDB(t)
+	mock.ExpectQuery(`SELECT CASE WHEN "users"."id" > ? THEN ? ELSE ? END AS "case" FROM "users" WHERE "users"."id" = ?`).
+		WithArgs(int64(1), "new", "old", int64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"case"}).AddRow("new")).
+		RowsWillBeClosed()
+
+	// The result isn't a column of a table, it's mapped back by its derived output name
+	var label sqlite.Text
+	u := sqlite.NewTable[testUser]()
+	stmt := sqlite.Select(
+		sqlite.Case().When(u.ID.Gt(1), "new").Else("old").Into(&label),
+		sqlite.From(u),
+		sqlite.Where(u.ID.Eq(2)),
+	)
+	if err := db.Strict().QueryOne(context.Background(), stmt); err != nil {
+		t.Fatal(err)
+	}
+	if !label.IsScanned() || label.Get() != "new" {
+		t.Errorf("got label %q, want \"new\"", label.Get())
+	}
+}
@@ -20,7 +20,21 @@ type Dialect interface {
 	// e.g. SQLite 3.35.0+ and PostgreSQL support it, MySQL doesn't
 	SupportsReturning() bool
 
+	// SupportsDistinctOn returns whether the dialect supports DISTINCT ON (...)
+	// e.g. PostgreSQL supports it, SQLite and MySQL don't
+	SupportsDistinctOn() bool
+
 	// LimitOffset returns the SQL for LIMIT and OFFSET clauses
 	// Some dialects use different syntax (e.g. FETCH FIRST n ROWS ONLY)
-	LimitOffset(limit, offset *int) string
+	// limit and offset are already rendered placeholders or constants, an empty string means the part is absent
+	LimitOffset(limit, offset string) string
+
+	// OnConflict returns the SQL that introduces the upsert part of an INSERT statement for the given conflict target columns
+	// e.g. ON CONFLICT ("id") DO UPDATE SET for SQLite/PostgreSQL, ON DUPLICATE KEY UPDATE for MySQL
+	// If doNothing is true the conflicting row is skipped instead of updated
+	OnConflict(columns []string, doNothing bool) string
+
+	// Excluded returns the SQL that references the value proposed for insertion of a column in an upsert
+	// e.g. excluded."name" for SQLite/PostgreSQL, VALUES(`name`) for MySQL
+	Excluded(column string) string
 }
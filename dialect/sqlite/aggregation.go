@@ -26,6 +26,13 @@ func (a *Aggregation) As(name string) *Aggregation {
 	return a
 }
 
+// Distinct makes the aggregation only consider distinct values, e.g. SUM(DISTINCT col).
+// It can't be combined with CountAll or with Over, writing such an aggregation returns an error.
+func (a *Aggregation) Distinct() *Aggregation {
+	a.Aggregation.Distinct = true
+	return a
+}
+
 // Avg creates an AVG aggregation for SQLite
 // This is a wrapper around query.Avg that ensures type safety for SQLite
 func Avg(column schema.Column, resultPtr *Float) *Aggregation {
@@ -0,0 +1,61 @@
+package sqlite
+
+import (
+	"github.com/gogo-framework/db/internal/query"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// ArithmeticExpr represents an arithmetic or concatenation expression in SQLite.
+// It can be used in conditions, SET assignments and ORDER BY, or selected with Into.
+type ArithmeticExpr struct {
+	*query.ArithmeticExpression
+}
+
+// Into makes the expression selectable, its result is scanned into resultPtr
+func (a *ArithmeticExpr) Into(resultPtr schema.Column) *ExpressionColumn {
+	return &ExpressionColumn{
+		ExpressionColumn: a.ArithmeticExpression.Into(resultPtr),
+	}
+}
+
+// Add creates an addition expression (e.g., col + 1)
+func Add[T any](left query.Expression, value T) *ArithmeticExpr {
+	return &ArithmeticExpr{
+		ArithmeticExpression: query.Add(left, value),
+	}
+}
+
+// Sub creates a subtraction expression (e.g., col - 1)
+func Sub[T any](left query.Expression, value T) *ArithmeticExpr {
+	return &ArithmeticExpr{
+		ArithmeticExpression: query.Sub(left, value),
+	}
+}
+
+// Mul creates a multiplication expression (e.g., price * quantity)
+func Mul[T any](left query.Expression, value T) *ArithmeticExpr {
+	return &ArithmeticExpr{
+		ArithmeticExpression: query.Mul(left, value),
+	}
+}
+
+// Div creates a division expression (e.g., total / count)
+func Div[T any](left query.Expression, value T) *ArithmeticExpr {
+	return &ArithmeticExpr{
+		ArithmeticExpression: query.Div(left, value),
+	}
+}
+
+// Modulo creates a modulo expression (e.g., id % 2), use Mod for the MOD function
+func Modulo[T any](left query.Expression, value T) *ArithmeticExpr {
+	return &ArithmeticExpr{
+		ArithmeticExpression: query.Modulo(left, value),
+	}
+}
+
+// Concat creates a string concatenation (e.g., first || ' ' || last)
+func Concat(left query.Expression, values ...any) *ArithmeticExpr {
+	return &ArithmeticExpr{
+		ArithmeticExpression: query.Concat(left, values...),
+	}
+}
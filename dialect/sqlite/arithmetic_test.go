@@ -0,0 +1,49 @@
+package sqlite
+
+import "testing"
+
+func TestArithmetic(t *testing.T) {
+	u := NewTable[testUser]()
+
+	var total Float
+	var label Text
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "nested left operand",
+			stmt: Select(Mul(Add(&u.Age, 1), 2).Into(&total), From(u)),
+			sql:  `SELECT ("users"."age" + ?) * ? AS "expr" FROM "users"`,
+			args: []any{1, 2},
+		},
+		{
+			name: "nested right operand",
+			stmt: Select(Sub(&u.Score, Sub(&u.Age, 1)).Into(&total), From(u)),
+			sql:  `SELECT "users"."score" - ("users"."age" - ?) AS "expr" FROM "users"`,
+			args: []any{1},
+		},
+		{
+			name: "same precedence on the left needs no parentheses",
+			stmt: Select(Add(Add(&u.Age, 1), 2).Into(&total), From(u)),
+			sql:  `SELECT "users"."age" + ? + ? AS "expr" FROM "users"`,
+			args: []any{1, 2},
+		},
+		{
+			name: "concatenation",
+			stmt: Select(Concat(&u.Name, " <", &u.Email, ">").Into(&label), From(u)),
+			sql:  `SELECT "users"."name" || ? || "users"."email" || ? AS "expr" FROM "users"`,
+			args: []any{" <", ">"},
+		},
+		{
+			name: "expression in a condition and ORDER BY",
+			stmt: Select(&u.ID, From(u), Where(Gt(Div(&u.Score, &u.Age), 1.5)), OrderBy(Desc(Modulo(&u.ID, 2)))),
+			sql:  `SELECT "users"."id" FROM "users" WHERE "users"."score" / "users"."age" > ? ORDER BY "users"."id" % ? DESC`,
+			args: []any{1.5, 2},
+		},
+		{
+			name: "bitwise operators",
+			stmt: Select(&u.ID, From(u), Where(u.Flags.BitOr(1).Neq(0), u.Flags.BitAnd(6).Gt(2))),
+			sql:  `SELECT "users"."id" FROM "users" WHERE "users"."flags" | ? != ? AND "users"."flags" & ? > ?`,
+			args: []any{int64(1), int64(0), int64(6), int64(2)},
+		},
+	})
+}
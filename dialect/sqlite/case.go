@@ -0,0 +1,38 @@
+package sqlite
+
+import (
+	"github.com/gogo-framework/db/internal/query"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// CaseExpr represents a SQLite CASE expression
+type CaseExpr struct {
+	*query.CaseExpression
+}
+
+// Case creates a CASE expression, add branches with When and an optional Else.
+// The expression can be used in OrderBy, GroupBy and conditions, or selected with Into.
+func Case() *CaseExpr {
+	return &CaseExpr{
+		CaseExpression: &query.CaseExpression{},
+	}
+}
+
+// When adds a WHEN condition THEN value branch, value can be an expression such as a column or a value that is bound as a parameter
+func (c *CaseExpr) When(condition query.Condition, value any) *CaseExpr {
+	c.CaseExpression.When(condition, value)
+	return c
+}
+
+// Else sets the ELSE value of the expression
+func (c *CaseExpr) Else(value any) *CaseExpr {
+	c.CaseExpression.Otherwise(value)
+	return c
+}
+
+// Into makes the CASE expression selectable, its result is scanned into resultPtr
+func (c *CaseExpr) Into(resultPtr schema.Column) *ExpressionColumn {
+	return &ExpressionColumn{
+		ExpressionColumn: c.CaseExpression.Into(resultPtr),
+	}
+}
@@ -0,0 +1,41 @@
+package sqlite
+
+import "testing"
+
+func TestCase(t *testing.T) {
+	u := NewTable[testUser]()
+
+	var label Text
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "searched CASE into a column",
+			stmt: Select(Case().When(u.Age.Lt(18), "minor").When(u.Age.Lt(65), "adult").Else("senior").Into(&label), From(u)),
+			sql:  `SELECT CASE WHEN "users"."age" < ? THEN ? WHEN "users"."age" < ? THEN ? ELSE ? END AS "case" FROM "users"`,
+			args: []any{int64(18), "minor", int64(65), "adult", "senior"},
+		},
+		{
+			name: "CASE without ELSE",
+			stmt: Select(Case().When(u.Email.IsNull(), "unknown").Into(&label), From(u)),
+			sql:  `SELECT CASE WHEN "users"."email" IS NULL THEN ? END AS "case" FROM "users"`,
+			args: []any{"unknown"},
+		},
+		{
+			name: "CASE in ORDER BY",
+			stmt: Select(&u.ID, From(u), OrderBy(Case().When(u.Name.Eq("root"), 0).Else(1), &u.Name)),
+			sql:  `SELECT "users"."id" FROM "users" ORDER BY CASE WHEN "users"."name" = ? THEN ? ELSE ? END, "users"."name"`,
+			args: []any{"root", 0, 1},
+		},
+		{
+			name: "CASE in a condition",
+			stmt: Select(&u.ID, From(u), Where(Eq(Case().When(u.Age.Gte(18), &u.Score).Else(0), 10))),
+			sql:  `SELECT "users"."id" FROM "users" WHERE CASE WHEN "users"."age" >= ? THEN "users"."score" ELSE ? END = ?`,
+			args: []any{int64(18), 0, 10},
+		},
+		{
+			name: "CASE without WHEN branches",
+			stmt: Select(Case().Else("none").Into(&label), From(u)),
+			err:  "CASE expression has no WHEN branches",
+		},
+	})
+}
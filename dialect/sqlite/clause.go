@@ -38,8 +38,20 @@ type FromClause struct {
 	invalidSource bool
 }
 
+// ApplySelect sets a copy of the FROM clause on the statement,
+// so joins that are added to the statement don't change a FROM clause that's shared with other statements
 func (f *FromClause) ApplySelect(stmt *SelectStmt) {
-	stmt.from = f
+	from := *f.FromClause
+	from.Joins = nil
+	// Keep joins that were applied before the FROM clause itself
+	if stmt.from != nil {
+		from.Joins = append(from.Joins, stmt.from.Joins...)
+	}
+	from.Joins = append(from.Joins, f.Joins...)
+	stmt.from = &FromClause{
+		FromClause:    &from,
+		invalidSource: f.invalidSource,
+	}
 }
 
 func (f *FromClause) WriteSql(ctx context.Context, writer io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
@@ -55,7 +67,7 @@ func (f *FromClause) As(alias string) SelectPart {
 }
 
 // From creates a FROM clause
-func From(source schema.Tabler) *FromClause {
+func From(source schema.Table) *FromClause {
 	return &FromClause{
 		FromClause: &query.FromClause{
 			Source: source,
@@ -63,6 +75,66 @@ func From(source schema.Tabler) *FromClause {
 	}
 }
 
+// JoinClause represents a JOIN in SQLite
+type JoinClause struct {
+	*query.JoinClause
+}
+
+func (j *JoinClause) ApplySelect(stmt *SelectStmt) {
+	if stmt.from == nil {
+		stmt.from = &FromClause{
+			FromClause: &query.FromClause{},
+		}
+	}
+	stmt.from.AppendJoins(j.JoinClause)
+}
+
+// As sets the alias of the joined table
+func (j *JoinClause) As(alias string) *JoinClause {
+	j.JoinClause.As(alias)
+	return j
+}
+
+// On sets the join conditions, multiple conditions are joined by AND
+func (j *JoinClause) On(conditions ...query.Condition) *JoinClause {
+	j.JoinClause.On(conditions...)
+	return j
+}
+
+func newJoin(joinType query.JoinType, table schema.Table) *JoinClause {
+	return &JoinClause{
+		JoinClause: &query.JoinClause{
+			Type:  joinType,
+			Table: table,
+		},
+	}
+}
+
+// InnerJoin creates an INNER JOIN
+func InnerJoin(table schema.Table) *JoinClause {
+	return newJoin(query.JoinInner, table)
+}
+
+// LeftJoin creates a LEFT JOIN
+func LeftJoin(table schema.Table) *JoinClause {
+	return newJoin(query.JoinLeft, table)
+}
+
+// RightJoin creates a RIGHT JOIN (SQLite 3.39.0+)
+func RightJoin(table schema.Table) *JoinClause {
+	return newJoin(query.JoinRight, table)
+}
+
+// FullJoin creates a FULL JOIN (SQLite 3.39.0+)
+func FullJoin(table schema.Table) *JoinClause {
+	return newJoin(query.JoinFull, table)
+}
+
+// CrossJoin creates a CROSS JOIN, which does not accept ON conditions
+func CrossJoin(table schema.Table) *JoinClause {
+	return newJoin(query.JoinCross, table)
+}
+
 // WhereClause represents a WHERE clause in SQLite
 type WhereClause struct {
 	*query.WhereClause
@@ -72,6 +144,14 @@ func (w *WhereClause) ApplySelect(stmt *SelectStmt) {
 	stmt.where = w
 }
 
+func (w *WhereClause) ApplyUpdate(stmt *UpdateStmt) {
+	stmt.where = w
+}
+
+func (w *WhereClause) ApplyDelete(stmt *DeleteStmt) {
+	stmt.where = w
+}
+
 // Where creates a WHERE clause
 func Where(conditions ...query.Condition) *WhereClause {
 	return &WhereClause{
@@ -96,6 +176,14 @@ func (o *OrderByClause) ApplySelect(stmt *SelectStmt) {
 	stmt.orderBy = o
 }
 
+func (o *OrderByClause) ApplyUpdate(stmt *UpdateStmt) {
+	stmt.orderBy = o
+}
+
+func (o *OrderByClause) ApplyDelete(stmt *DeleteStmt) {
+	stmt.orderBy = o
+}
+
 // OrderBy creates an ORDER BY clause
 func OrderBy(columns ...query.Expression) *OrderByClause {
 	return &OrderByClause{
@@ -105,13 +193,61 @@ func OrderBy(columns ...query.Expression) *OrderByClause {
 	}
 }
 
+// Asc sorts by the expression in ascending order
+func Asc(expr query.Expression) *query.OrderTerm {
+	return query.Asc(expr)
+}
+
+// Desc sorts by the expression in descending order
+func Desc(expr query.Expression) *query.OrderTerm {
+	return query.Desc(expr)
+}
+
+// NullsFirst places NULL values before other values (SQLite 3.30.0+), e.g. NullsFirst(Desc(&user.Age))
+func NullsFirst(expr query.Expression) *query.OrderTerm {
+	return query.WithNulls(expr, query.NullsFirst)
+}
+
+// NullsLast places NULL values after other values (SQLite 3.30.0+), e.g. NullsLast(Asc(&user.Age))
+func NullsLast(expr query.Expression) *query.OrderTerm {
+	return query.WithNulls(expr, query.NullsLast)
+}
+
+// Collate applies a collating sequence such as "NOCASE", "RTRIM" or "BINARY" to the expression
+func Collate(expr query.Expression, collation string) *query.CollateExpression {
+	return query.Collate(expr, collation)
+}
+
 // LimitOffsetClause represents a LIMIT and OFFSET clause in SQLite
 type LimitOffsetClause struct {
 	*query.LimitOffsetClause
 }
 
 func (l *LimitOffsetClause) ApplySelect(stmt *SelectStmt) {
-	stmt.limitOffset = l.LimitOffsetClause
+	stmt.limitOffset = l.merge(stmt.limitOffset)
+}
+
+func (l *LimitOffsetClause) ApplyUpdate(stmt *UpdateStmt) {
+	stmt.limitOffset = l.merge(stmt.limitOffset)
+}
+
+func (l *LimitOffsetClause) ApplyDelete(stmt *DeleteStmt) {
+	stmt.limitOffset = l.merge(stmt.limitOffset)
+}
+
+// merge combines the clause with the LIMIT and OFFSET already applied to a statement.
+// The result is always a copy, so a part that is reused for multiple statements isn't modified by another part.
+func (l *LimitOffsetClause) merge(current *query.LimitOffsetClause) *query.LimitOffsetClause {
+	if current == nil {
+		return (&query.LimitOffsetClause{}).Merge(l.LimitOffsetClause)
+	}
+	return current.Merge(l.LimitOffsetClause)
+}
+
+// Inline writes the limit and offset into the SQL as constants instead of binding them as parameters
+func (l *LimitOffsetClause) Inline() *LimitOffsetClause {
+	l.LimitOffsetClause.Inline = true
+	return l
 }
 
 // LimitOffset creates a LIMIT and OFFSET clause
@@ -151,6 +287,13 @@ func (d *DistinctClause) ApplySelect(stmt *SelectStmt) {
 	stmt.distinct = d
 }
 
+// On restricts the DISTINCT to the given expressions.
+// SQLite doesn't support DISTINCT ON, writing the statement returns an error.
+func (d *DistinctClause) On(exprs ...query.Expression) *DistinctClause {
+	d.DistinctClause.On(exprs...)
+	return d
+}
+
 // Distinct creates a DISTINCT clause
 func Distinct() *DistinctClause {
 	return &DistinctClause{
@@ -193,3 +336,158 @@ func Having(conditions ...query.Condition) *HavingClause {
 		},
 	}
 }
+
+// SetClause represents the SET clause of an UPDATE statement in SQLite
+type SetClause struct {
+	*query.SetClause
+}
+
+func (s *SetClause) ApplyUpdate(stmt *UpdateStmt) {
+	if stmt.set == nil {
+		stmt.set = &query.SetClause{}
+	}
+	stmt.set.Assignments = append(stmt.set.Assignments, s.Assignments...)
+}
+
+// Set creates a SET assignment, multiple Set parts are combined into a single SET clause.
+// The value can be an expression such as another column or Add(&column, 1), other values are bound as parameters.
+func Set[T any](column schema.Column, value T) *SetClause {
+	return &SetClause{
+		SetClause: &query.SetClause{
+			Assignments: []*query.Assignment{query.Set(column, value)},
+		},
+	}
+}
+
+// AllRowsClause explicitly allows a statement to run without a WHERE clause
+type AllRowsClause struct{}
+
+func (a *AllRowsClause) ApplyUpdate(stmt *UpdateStmt) {
+	stmt.allRows = true
+}
+
+func (a *AllRowsClause) ApplyDelete(stmt *DeleteStmt) {
+	stmt.allRows = true
+}
+
+// AllRows allows an UPDATE or DELETE to affect every row of the table.
+// Without it, rendering a statement without WHERE conditions results in an error.
+func AllRows() *AllRowsClause {
+	return &AllRowsClause{}
+}
+
+// ReturningClause represents a RETURNING clause in SQLite
+type ReturningClause struct {
+	*query.ReturningClause
+}
+
+func (r *ReturningClause) ApplyInsert(stmt *InsertStmt) {
+	stmt.returning = r.ReturningClause
+}
+
+func (r *ReturningClause) ApplyUpdate(stmt *UpdateStmt) {
+	stmt.returning = r.ReturningClause
+}
+
+func (r *ReturningClause) ApplyDelete(stmt *DeleteStmt) {
+	stmt.returning = r.ReturningClause
+}
+
+// Returning creates a RETURNING clause for the given columns.
+// After execution the returned rows can be scanned back into these columns with ScanReturning on the statement.
+func Returning(columns ...schema.Column) *ReturningClause {
+	return &ReturningClause{
+		ReturningClause: &query.ReturningClause{
+			Columns: columns,
+		},
+	}
+}
+
+// OnConflictClause represents the ON CONFLICT clause of an INSERT statement in SQLite
+type OnConflictClause struct {
+	*query.OnConflictClause
+}
+
+func (c *OnConflictClause) ApplyInsert(stmt *InsertStmt) {
+	stmt.onConflict = c.OnConflictClause
+}
+
+// OnConflict creates an ON CONFLICT clause for the given conflict target columns,
+// it must be completed with either DoNothing or DoUpdateSet.
+func OnConflict(columns ...schema.Column) *OnConflictClause {
+	return &OnConflictClause{
+		OnConflictClause: &query.OnConflictClause{
+			Columns: columns,
+		},
+	}
+}
+
+// DoNothing skips the insert of conflicting rows
+func (c *OnConflictClause) DoNothing() *OnConflictClause {
+	c.OnConflictClause.DoNothing = true
+	return c
+}
+
+// DoUpdateSet updates the conflicting row with the given assignments,
+// use Excluded to reference the values that were proposed for insertion.
+func (c *OnConflictClause) DoUpdateSet(assignments ...*SetClause) *OnConflictClause {
+	c.OnConflictClause.DoNothing = false
+	if c.Set == nil {
+		c.Set = &query.SetClause{}
+	}
+	for _, assignment := range assignments {
+		c.Set.Assignments = append(c.Set.Assignments, assignment.Assignments...)
+	}
+	return c
+}
+
+// Where adds conditions to the update branch, rows that do not match are not updated
+func (c *OnConflictClause) Where(conditions ...query.Condition) *OnConflictClause {
+	if c.OnConflictClause.Where == nil {
+		c.OnConflictClause.Where = &query.WhereClause{}
+	}
+	c.OnConflictClause.Where.Conditions = append(c.OnConflictClause.Where.Conditions, conditions...)
+	return c
+}
+
+// ExcludedColumn references the value that was proposed for insertion of a column (excluded."column").
+// It has the value type T of the column, so it can be assigned with Set and compared like the column itself.
+type ExcludedColumn[T any] struct {
+	*query.ExcludedColumn[T]
+}
+
+func (e *ExcludedColumn[T]) Eq(value T) query.Condition {
+	return query.Eq(e, value)
+}
+
+func (e *ExcludedColumn[T]) Neq(value T) query.Condition {
+	return query.Neq(e, value)
+}
+
+func (e *ExcludedColumn[T]) Gt(value T) query.Condition {
+	return query.Gt(e, value)
+}
+
+func (e *ExcludedColumn[T]) Gte(value T) query.Condition {
+	return query.Gte(e, value)
+}
+
+func (e *ExcludedColumn[T]) Lt(value T) query.Condition {
+	return query.Lt(e, value)
+}
+
+func (e *ExcludedColumn[T]) Lte(value T) query.Condition {
+	return query.Lte(e, value)
+}
+
+// Excluded references the value that was proposed for insertion of the given column, e.g.
+//
+//	OnConflict(&u.Email).DoUpdateSet(Set(&u.Score, Excluded(&u.Score))).Where(Excluded(&u.Score).Gt(0))
+func Excluded[T any, C interface {
+	schema.Column
+	Get() T
+}](column C) *ExcludedColumn[T] {
+	return &ExcludedColumn[T]{
+		ExcludedColumn: query.Excluded[T](column),
+	}
+}
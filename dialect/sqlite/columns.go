@@ -1 +1,275 @@
 package sqlite
+
+import (
+	"github.com/gogo-framework/db/internal/query"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// The column types of SQLite. Every type only has the condition methods that make sense for its values,
+// so e.g. a LIKE on an INTEGER column or comparing a TEXT column with a number doesn't compile.
+// The Null variants are meant for nullable columns, only they have the IsNull and IsNotNull conditions.
+// The generic condition functions (Eq, Gt, ...) can still be used to compare a column with another expression.
+
+// column implements the conditions that are supported by every column type
+type column[T any] struct {
+	schema.BaseColumn[T]
+}
+
+// ApplySelect adds the column to the select list
+func (c *column[T]) ApplySelect(stmt *SelectStmt) {
+	if stmt.Columns == nil {
+		stmt.Columns = &SelectClause{
+			SelectClause: &query.SelectClause{},
+		}
+	}
+	stmt.Columns.Columns = append(stmt.Columns.Columns, c)
+}
+
+func (c *column[T]) Eq(value T) query.Condition {
+	return query.Eq(c, value)
+}
+
+func (c *column[T]) Neq(value T) query.Condition {
+	return query.Neq(c, value)
+}
+
+func (c *column[T]) In(values ...T) query.Condition {
+	return query.In(c, values...)
+}
+
+func (c *column[T]) NotIn(values ...T) query.Condition {
+	return query.NotIn(c, values...)
+}
+
+// orderedColumn adds the conditions of column types whose values are ordered
+type orderedColumn[T any] struct {
+	column[T]
+}
+
+func (c *orderedColumn[T]) Gt(value T) query.Condition {
+	return query.Gt(c, value)
+}
+
+func (c *orderedColumn[T]) Gte(value T) query.Condition {
+	return query.Gte(c, value)
+}
+
+func (c *orderedColumn[T]) Lt(value T) query.Condition {
+	return query.Lt(c, value)
+}
+
+func (c *orderedColumn[T]) Lte(value T) query.Condition {
+	return query.Lte(c, value)
+}
+
+func (c *orderedColumn[T]) Between(low, high T) query.Condition {
+	return query.Between(c, low, high)
+}
+
+func (c *orderedColumn[T]) NotBetween(low, high T) query.Condition {
+	return query.NotBetween(c, low, high)
+}
+
+// integerColumn adds the bitwise operations of INTEGER columns
+type integerColumn struct {
+	orderedColumn[int64]
+}
+
+// BitAnd creates a bitwise AND expression (e.g., flags & 4)
+func (c *integerColumn) BitAnd(value int64) *IntegerExpr {
+	return newIntegerExpr(query.BitAnd(c, value))
+}
+
+// BitOr creates a bitwise OR expression (e.g., flags | 4)
+func (c *integerColumn) BitOr(value int64) *IntegerExpr {
+	return newIntegerExpr(query.BitOr(c, value))
+}
+
+// ShiftLeft creates a left shift expression (e.g., flags << 2)
+func (c *integerColumn) ShiftLeft(n int64) *IntegerExpr {
+	return newIntegerExpr(query.ShiftLeft(c, n))
+}
+
+// ShiftRight creates a right shift expression (e.g., flags >> 2)
+func (c *integerColumn) ShiftRight(n int64) *IntegerExpr {
+	return newIntegerExpr(query.ShiftRight(c, n))
+}
+
+// IntegerExpr is an expression with an INTEGER result, such as the bitwise operations of an INTEGER column.
+// It has the conditions of an INTEGER column, e.g. u.Flags.BitAnd(4).Eq(4).
+type IntegerExpr struct {
+	*ArithmeticExpr
+}
+
+func newIntegerExpr(expr *query.ArithmeticExpression) *IntegerExpr {
+	return &IntegerExpr{
+		ArithmeticExpr: &ArithmeticExpr{ArithmeticExpression: expr},
+	}
+}
+
+func (e *IntegerExpr) Eq(value int64) query.Condition {
+	return query.Eq(e, value)
+}
+
+func (e *IntegerExpr) Neq(value int64) query.Condition {
+	return query.Neq(e, value)
+}
+
+func (e *IntegerExpr) In(values ...int64) query.Condition {
+	return query.In(e, values...)
+}
+
+func (e *IntegerExpr) NotIn(values ...int64) query.Condition {
+	return query.NotIn(e, values...)
+}
+
+func (e *IntegerExpr) Gt(value int64) query.Condition {
+	return query.Gt(e, value)
+}
+
+func (e *IntegerExpr) Gte(value int64) query.Condition {
+	return query.Gte(e, value)
+}
+
+func (e *IntegerExpr) Lt(value int64) query.Condition {
+	return query.Lt(e, value)
+}
+
+func (e *IntegerExpr) Lte(value int64) query.Condition {
+	return query.Lte(e, value)
+}
+
+func (e *IntegerExpr) Between(low, high int64) query.Condition {
+	return query.Between(e, low, high)
+}
+
+func (e *IntegerExpr) NotBetween(low, high int64) query.Condition {
+	return query.NotBetween(e, low, high)
+}
+
+// textColumn adds the pattern matching conditions of TEXT columns
+type textColumn struct {
+	orderedColumn[string]
+}
+
+func (c *textColumn) Like(pattern string) query.Condition {
+	return query.Like(c, pattern)
+}
+
+func (c *textColumn) NotLike(pattern string) query.Condition {
+	return query.NotLike(c, pattern)
+}
+
+func (c *textColumn) Glob(pattern string) query.Condition {
+	return query.Glob(c, pattern)
+}
+
+// Integer represents an INTEGER column
+type Integer struct {
+	integerColumn
+}
+
+// NullInteger represents a nullable INTEGER column
+type NullInteger struct {
+	integerColumn
+}
+
+func (c *NullInteger) IsNull() query.Condition {
+	return query.IsNull(c)
+}
+
+func (c *NullInteger) IsNotNull() query.Condition {
+	return query.IsNotNull(c)
+}
+
+// Text represents a TEXT column
+type Text struct {
+	textColumn
+}
+
+// NullText represents a nullable TEXT column
+type NullText struct {
+	textColumn
+}
+
+func (c *NullText) IsNull() query.Condition {
+	return query.IsNull(c)
+}
+
+func (c *NullText) IsNotNull() query.Condition {
+	return query.IsNotNull(c)
+}
+
+// Float represents a REAL column
+type Float struct {
+	orderedColumn[float64]
+}
+
+// NullFloat represents a nullable REAL column
+type NullFloat struct {
+	orderedColumn[float64]
+}
+
+func (c *NullFloat) IsNull() query.Condition {
+	return query.IsNull(c)
+}
+
+func (c *NullFloat) IsNotNull() query.Condition {
+	return query.IsNotNull(c)
+}
+
+// Numeric represents a NUMERIC column.
+// The value is kept as a string, so decimals aren't rounded by a conversion to float64.
+// Values are compared numerically, as SQLite applies the NUMERIC affinity of the column to them.
+type Numeric struct {
+	orderedColumn[string]
+}
+
+// NullNumeric represents a nullable NUMERIC column, see Numeric
+type NullNumeric struct {
+	orderedColumn[string]
+}
+
+func (c *NullNumeric) IsNull() query.Condition {
+	return query.IsNull(c)
+}
+
+func (c *NullNumeric) IsNotNull() query.Condition {
+	return query.IsNotNull(c)
+}
+
+// Blob represents a BLOB column, blobs can only be compared for equality
+type Blob struct {
+	column[[]byte]
+}
+
+// NullBlob represents a nullable BLOB column
+type NullBlob struct {
+	column[[]byte]
+}
+
+func (c *NullBlob) IsNull() query.Condition {
+	return query.IsNull(c)
+}
+
+func (c *NullBlob) IsNotNull() query.Condition {
+	return query.IsNotNull(c)
+}
+
+// Boolean represents a BOOLEAN column, SQLite stores the values as the integers 0 and 1
+type Boolean struct {
+	column[bool]
+}
+
+// NullBoolean represents a nullable BOOLEAN column
+type NullBoolean struct {
+	column[bool]
+}
+
+func (c *NullBoolean) IsNull() query.Condition {
+	return query.IsNull(c)
+}
+
+func (c *NullBoolean) IsNotNull() query.Condition {
+	return query.IsNotNull(c)
+}
@@ -0,0 +1,191 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gogo-framework/db/dialect"
+	"github.com/gogo-framework/db/internal/query"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// CompoundPart represents a part of a compound statement that can be applied to a CompoundStmt
+type CompoundPart interface {
+	ApplyCompound(*CompoundStmt)
+}
+
+// compoundBranch is a SELECT statement and the operator that combines it with the previous branch
+type compoundBranch struct {
+	op   query.CompoundOperator
+	stmt *SelectStmt
+}
+
+// CompoundStmt represents SELECT statements combined by UNION, UNION ALL, INTERSECT or EXCEPT.
+// The ORDER BY and LIMIT/OFFSET clauses apply to the result of the compound.
+type CompoundStmt struct {
+	// can be removed later, right now it's used in the toSql method but this is only for testing
+	dialect     dialect.Dialect
+	op          query.CompoundOperator
+	branches    []compoundBranch
+	orderBy     *OrderByClause
+	limitOffset *query.LimitOffsetClause
+}
+
+func newCompound(op query.CompoundOperator, parts ...CompoundPart) *CompoundStmt {
+	stmt := &CompoundStmt{
+		dialect: &SqliteDialect{},
+		op:      op,
+	}
+	for _, part := range parts {
+		if part != nil {
+			part.ApplyCompound(stmt)
+		}
+	}
+	return stmt
+}
+
+// Union combines the SELECT statements with UNION
+func Union(parts ...CompoundPart) *CompoundStmt {
+	return newCompound(query.CompoundUnion, parts...)
+}
+
+// UnionAll combines the SELECT statements with UNION ALL
+func UnionAll(parts ...CompoundPart) *CompoundStmt {
+	return newCompound(query.CompoundUnionAll, parts...)
+}
+
+// Intersect combines the SELECT statements with INTERSECT
+func Intersect(parts ...CompoundPart) *CompoundStmt {
+	return newCompound(query.CompoundIntersect, parts...)
+}
+
+// Except combines the SELECT statements with EXCEPT
+func Except(parts ...CompoundPart) *CompoundStmt {
+	return newCompound(query.CompoundExcept, parts...)
+}
+
+// ApplyCompound adds the SELECT statement as a branch of the compound statement
+func (s *SelectStmt) ApplyCompound(stmt *CompoundStmt) {
+	stmt.branches = append(stmt.branches, compoundBranch{op: stmt.op, stmt: s})
+}
+
+func (o *OrderByClause) ApplyCompound(stmt *CompoundStmt) {
+	stmt.orderBy = o
+}
+
+func (l *LimitOffsetClause) ApplyCompound(stmt *CompoundStmt) {
+	stmt.limitOffset = l.merge(stmt.limitOffset)
+}
+
+// Union adds a branch combined with UNION
+func (c *CompoundStmt) Union(stmt *SelectStmt) *CompoundStmt {
+	c.branches = append(c.branches, compoundBranch{op: query.CompoundUnion, stmt: stmt})
+	return c
+}
+
+// UnionAll adds a branch combined with UNION ALL
+func (c *CompoundStmt) UnionAll(stmt *SelectStmt) *CompoundStmt {
+	c.branches = append(c.branches, compoundBranch{op: query.CompoundUnionAll, stmt: stmt})
+	return c
+}
+
+// Intersect adds a branch combined with INTERSECT
+func (c *CompoundStmt) Intersect(stmt *SelectStmt) *CompoundStmt {
+	c.branches = append(c.branches, compoundBranch{op: query.CompoundIntersect, stmt: stmt})
+	return c
+}
+
+// Except adds a branch combined with EXCEPT
+func (c *CompoundStmt) Except(stmt *SelectStmt) *CompoundStmt {
+	c.branches = append(c.branches, compoundBranch{op: query.CompoundExcept, stmt: stmt})
+	return c
+}
+
+// WriteSql generates the SQL for the compound statement
+func (c *CompoundStmt) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	var args []any
+
+	if len(c.branches) < 2 {
+		return nil, fmt.Errorf("compound statement needs at least two SELECT statements, got %d", len(c.branches))
+	}
+
+	// All branches must select the same number of columns, branches selecting * can't be checked
+	columnCount := -1
+	for i, branch := range c.branches {
+		if branch.stmt.orderBy != nil || branch.stmt.limitOffset != nil {
+			return nil, fmt.Errorf("SELECT %d of compound statement has ORDER BY or LIMIT, which is only allowed on the compound statement", i+1)
+		}
+		if branch.stmt.Columns == nil {
+			continue
+		}
+		count := len(branch.stmt.Columns.Columns)
+		if columnCount == -1 {
+			columnCount = count
+		} else if count != columnCount {
+			return nil, fmt.Errorf("SELECT %d of compound statement has %d columns, expected %d", i+1, count, columnCount)
+		}
+	}
+
+	// The WITH clause of the first branch prefixes the compound statement, all branches can reference it
+	ctx = c.branches[0].stmt.with.Scope(ctx)
+
+	// Write the branches
+	for i, branch := range c.branches {
+		if i > 0 {
+			if _, err := w.Write([]byte(" " + string(branch.op) + " ")); err != nil {
+				return nil, fmt.Errorf("error writing %s: %w", branch.op, err)
+			}
+		}
+		branchArgs, err := branch.stmt.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing SELECT %d of compound statement: %w", i+1, err)
+		}
+		args = append(args, branchArgs...)
+	}
+
+	// Write ORDER BY
+	if c.orderBy != nil {
+		if _, err := w.Write([]byte(" ORDER BY ")); err != nil {
+			return nil, fmt.Errorf("error writing ORDER BY: %w", err)
+		}
+		orderArgs, err := c.orderBy.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing ORDER BY clause: %w", err)
+		}
+		args = append(args, orderArgs...)
+	}
+
+	// Write LIMIT and OFFSET
+	if c.limitOffset != nil {
+		limitOffsetArgs, err := c.limitOffset.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing LIMIT/OFFSET clause: %w", err)
+		}
+		args = append(args, limitOffsetArgs...)
+	}
+
+	return args, nil
+}
+
+// AsSubquery implements the query.Statement interface, so the statement can be used as an operand of a condition
+func (c *CompoundStmt) AsSubquery() *query.Subquery {
+	return query.NewSubquery(c)
+}
+
+// ResultColumns returns the columns of the first branch, the rows of the compound are scanned into them
+func (c *CompoundStmt) ResultColumns() []schema.Column {
+	if len(c.branches) == 0 {
+		return nil
+	}
+	return c.branches[0].stmt.ResultColumns()
+}
+
+// ToSql converts the statement to SQL string with arguments (for testing)
+func (c *CompoundStmt) ToSql() (string, []any) {
+	ctx := context.Background()
+	w := &bytes.Buffer{}
+	args, _ := c.WriteSql(ctx, w, c.dialect, 1)
+	return w.String(), args
+}
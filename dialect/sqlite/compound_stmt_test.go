@@ -0,0 +1,52 @@
+package sqlite
+
+import "testing"
+
+func TestCompound(t *testing.T) {
+	u := NewTable[testUser]()
+	p := NewTable[testPost]()
+
+	adultID := &Integer{}
+	adults := With("adults", Select(&u.ID, From(u), Where(u.Age.Gte(18)))).Column("id", adultID)
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "union",
+			stmt: Union(Select(&u.ID, From(u)), Select(&p.UserID, From(p))),
+			sql:  `SELECT "users"."id" FROM "users" UNION SELECT "posts"."user_id" FROM "posts"`,
+		},
+		{
+			name: "union all with ORDER BY and LIMIT",
+			stmt: UnionAll(Select(&u.Name, From(u), Where(u.Age.Lt(18))), Select(&u.Name, From(u), Where(u.Age.Gt(65))), OrderBy(&u.Name), Limit(10)),
+			sql:  `SELECT "users"."name" FROM "users" WHERE "users"."age" < ? UNION ALL SELECT "users"."name" FROM "users" WHERE "users"."age" > ? ORDER BY "users"."name" LIMIT ?`,
+			args: []any{int64(18), int64(65), 10},
+		},
+		{
+			name: "mixed operators",
+			stmt: Union(Select(&u.ID, From(u)), Select(&p.UserID, From(p))).Except(Select(&u.ID, From(u), Where(u.Age.Lt(18)))).Intersect(Select(&p.UserID, From(p))),
+			sql:  `SELECT "users"."id" FROM "users" UNION SELECT "posts"."user_id" FROM "posts" EXCEPT SELECT "users"."id" FROM "users" WHERE "users"."age" < ? INTERSECT SELECT "posts"."user_id" FROM "posts"`,
+			args: []any{int64(18)},
+		},
+		{
+			name: "WITH of the first branch",
+			stmt: Union(Select(adultID, adults, From(adults)), Select(adultID, From(adults))),
+			sql:  `WITH "adults"("id") AS (SELECT "users"."id" FROM "users" WHERE "users"."age" >= ?) SELECT "adults"."id" FROM "adults" UNION SELECT "adults"."id" FROM "adults"`,
+			args: []any{int64(18)},
+		},
+		{
+			name: "single branch",
+			stmt: Union(Select(&u.ID, From(u))),
+			err:  "compound statement needs at least two SELECT statements",
+		},
+		{
+			name: "branches with different column counts",
+			stmt: Union(Select(&u.ID, From(u)), Select(&p.UserID, &p.Title, From(p))),
+			err:  "SELECT 2 of compound statement has 2 columns, expected 1",
+		},
+		{
+			name: "branch with ORDER BY",
+			stmt: Union(Select(&u.ID, From(u), OrderBy(&u.ID)), Select(&p.UserID, From(p))),
+			err:  "SELECT 1 of compound statement has ORDER BY or LIMIT",
+		},
+	})
+}
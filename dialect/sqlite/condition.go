@@ -2,41 +2,101 @@ package sqlite
 
 import (
 	"github.com/gogo-framework/db/internal/query"
-	"github.com/gogo-framework/db/internal/schema"
 )
 
 func Or(conditions ...query.Condition) query.Condition {
 	return &query.OrCondition{Conditions: conditions}
 }
 
-func Eq[T any](column schema.Column, value T) query.Condition {
+func Eq[T any](column query.Expression, value T) query.Condition {
 	return query.Eq(column, value)
 }
 
-func Neq[T any](column schema.Column, value T) query.Condition {
+func Neq[T any](column query.Expression, value T) query.Condition {
 	return query.Neq(column, value)
 }
 
-func Gt[T any](column schema.Column, value T) query.Condition {
+func Gt[T any](column query.Expression, value T) query.Condition {
 	return query.Gt(column, value)
 }
 
-func Gte[T any](column schema.Column, value T) query.Condition {
+func Gte[T any](column query.Expression, value T) query.Condition {
 	return query.Gte(column, value)
 }
 
-func Lt[T any](column schema.Column, value T) query.Condition {
+func Lt[T any](column query.Expression, value T) query.Condition {
 	return query.Lt(column, value)
 }
 
-func Lte[T any](column schema.Column, value T) query.Condition {
+func Lte[T any](column query.Expression, value T) query.Condition {
 	return query.Lte(column, value)
 }
 
-func Like(column schema.Column, pattern string) query.Condition {
+func Like(column query.Expression, pattern string) query.Condition {
 	return query.Like(column, pattern)
 }
 
-func In[T any](column schema.Column, values ...T) query.Condition {
+func In[T any](column query.Expression, values ...T) query.Condition {
 	return query.In(column, values...)
 }
+
+// Exists creates an EXISTS condition, stmt is a SELECT or compound statement or a Subquery
+func Exists(stmt query.Statement) query.Condition {
+	return query.Exists(stmt)
+}
+
+func And(conditions ...query.Condition) query.Condition {
+	return query.And(conditions...)
+}
+
+func Not(condition query.Condition) query.Condition {
+	return query.Not(condition)
+}
+
+func Between[T any](column query.Expression, low, high T) query.Condition {
+	return query.Between(column, low, high)
+}
+
+func NotBetween[T any](column query.Expression, low, high T) query.Condition {
+	return query.NotBetween(column, low, high)
+}
+
+// IsDistinctFrom requires SQLite 3.39.0+
+func IsDistinctFrom[T any](column query.Expression, value T) query.Condition {
+	return query.IsDistinctFrom(column, value)
+}
+
+// IsNotDistinctFrom requires SQLite 3.39.0+
+func IsNotDistinctFrom[T any](column query.Expression, value T) query.Condition {
+	return query.IsNotDistinctFrom(column, value)
+}
+
+func NotLike(column query.Expression, pattern string) query.Condition {
+	return query.NotLike(column, pattern)
+}
+
+func NotIn[T any](column query.Expression, values ...T) query.Condition {
+	return query.NotIn(column, values...)
+}
+
+func IsNull(column query.Expression) query.Condition {
+	return query.IsNull(column)
+}
+
+func IsNotNull(column query.Expression) query.Condition {
+	return query.IsNotNull(column)
+}
+
+func Glob(column query.Expression, pattern string) query.Condition {
+	return query.Glob(column, pattern)
+}
+
+// Match requires a MATCH implementation, e.g. a full-text search virtual table
+func Match(column query.Expression, pattern string) query.Condition {
+	return query.Match(column, pattern)
+}
+
+// Regexp requires a REGEXP function to be registered with the connection
+func Regexp(column query.Expression, pattern string) query.Condition {
+	return query.Regexp(column, pattern)
+}
@@ -0,0 +1,87 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/gogo-framework/db/internal/query"
+)
+
+func TestConditions(t *testing.T) {
+	u := NewTable[testUser]()
+	m := Alias[testUser]("m")
+
+	// The alias of a column only applies in the select list
+	a := NewTable[testUser]()
+	a.Age.SetAlias("years")
+
+	where := func(conditions ...query.Condition) *SelectStmt {
+		return Select(&u.ID, From(u), Where(conditions...))
+	}
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "column compared with a value",
+			stmt: where(u.Age.Gte(18), u.Name.Neq("root")),
+			sql:  `SELECT "users"."id" FROM "users" WHERE "users"."age" >= ? AND "users"."name" != ?`,
+			args: []any{int64(18), "root"},
+		},
+		{
+			name: "column compared with a column",
+			stmt: Select(&u.ID, From(u), InnerJoin(m).On(Eq(&u.ManagerID, &m.ID)), Where(Gt(&u.Age, &m.Age))),
+			sql:  `SELECT "users"."id" FROM "users" INNER JOIN "users" AS "m" ON "users"."manager_id" = "m"."id" WHERE "users"."age" > "m"."age"`,
+		},
+		{
+			name: "aliased column as operand",
+			stmt: Select(&a.Age, From(a), Where(a.Age.Gt(18), Eq(Add(&a.Age, 1), &a.Score))),
+			sql:  `SELECT "users"."age" AS "years" FROM "users" WHERE "users"."age" > ? AND "users"."age" + ? = "users"."score"`,
+			args: []any{int64(18), 1},
+		},
+		{
+			name: "nested OR and AND",
+			stmt: where(Or(u.Age.Lt(18), And(u.Age.Gt(65), u.Name.Like("a%")))),
+			sql:  `SELECT "users"."id" FROM "users" WHERE ("users"."age" < ? OR ("users"."age" > ? AND "users"."name" LIKE ?))`,
+			args: []any{int64(18), int64(65), "a%"},
+		},
+		{
+			name: "NOT",
+			stmt: where(Not(Or(u.Age.Lt(18), u.Email.IsNull()))),
+			sql:  `SELECT "users"."id" FROM "users" WHERE NOT ("users"."age" < ? OR "users"."email" IS NULL)`,
+			args: []any{int64(18)},
+		},
+		{
+			name: "BETWEEN",
+			stmt: where(u.Age.Between(18, 65), u.Score.NotBetween(0, 10)),
+			sql:  `SELECT "users"."id" FROM "users" WHERE "users"."age" BETWEEN ? AND ? AND "users"."score" NOT BETWEEN ? AND ?`,
+			args: []any{int64(18), int64(65), 0.0, 10.0},
+		},
+		{
+			name: "IS DISTINCT FROM",
+			stmt: where(IsDistinctFrom(&u.Email, "a@example.com"), IsNotDistinctFrom(&u.ManagerID, &m.ID)),
+			sql:  `SELECT "users"."id" FROM "users" WHERE "users"."email" IS DISTINCT FROM ? AND "users"."manager_id" IS NOT DISTINCT FROM "m"."id"`,
+			args: []any{"a@example.com"},
+		},
+		{
+			name: "IN and NOT IN values",
+			stmt: where(u.ID.In(1, 2, 3), u.Name.NotIn("root")),
+			sql:  `SELECT "users"."id" FROM "users" WHERE "users"."id" IN (?, ?, ?) AND "users"."name" NOT IN (?)`,
+			args: []any{int64(1), int64(2), int64(3), "root"},
+		},
+		{
+			name: "NULL checks",
+			stmt: where(u.Email.IsNotNull(), u.ManagerID.IsNull()),
+			sql:  `SELECT "users"."id" FROM "users" WHERE "users"."email" IS NOT NULL AND "users"."manager_id" IS NULL`,
+		},
+		{
+			name: "pattern conditions",
+			stmt: where(u.Name.NotLike("%bot"), u.Name.Glob("a*")),
+			sql:  `SELECT "users"."id" FROM "users" WHERE "users"."name" NOT LIKE ? AND "users"."name" GLOB ?`,
+			args: []any{"%bot", "a*"},
+		},
+		{
+			name: "bitwise expression compared with a value",
+			stmt: where(u.Flags.BitAnd(4).Eq(4)),
+			sql:  `SELECT "users"."id" FROM "users" WHERE "users"."flags" & ? = ?`,
+			args: []any{int64(4), int64(4)},
+		},
+	})
+}
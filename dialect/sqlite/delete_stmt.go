@@ -0,0 +1,138 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+
+	"github.com/gogo-framework/db/dialect"
+	"github.com/gogo-framework/db/internal/query"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// DeletePart represents a part of a DELETE statement that can be applied to a DeleteStmt
+type DeletePart interface {
+	ApplyDelete(*DeleteStmt)
+}
+
+// DeleteStmt represents a SQLite DELETE statement.
+// ORDER BY and LIMIT require SQLite to be compiled with SQLITE_ENABLE_UPDATE_DELETE_LIMIT.
+type DeleteStmt struct {
+	// can be removed later, right now it's used in the toSql method but this is only for testing
+	dialect     dialect.Dialect
+	table       schema.Table
+	where       *WhereClause
+	returning   *query.ReturningClause
+	orderBy     *OrderByClause
+	limitOffset *query.LimitOffsetClause
+	allRows     bool
+}
+
+// Delete creates a new SQLite DELETE statement for the given table
+func Delete(table schema.Table, parts ...DeletePart) *DeleteStmt {
+	stmt := &DeleteStmt{
+		dialect: &SqliteDialect{},
+		table:   table,
+	}
+	for _, part := range parts {
+		if part != nil {
+			part.ApplyDelete(stmt)
+		}
+	}
+	return stmt
+}
+
+// WriteSql generates the SQL for the DELETE statement
+func (s *DeleteStmt) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	var args []any
+
+	if s.table == nil {
+		return nil, fmt.Errorf("no table to delete from")
+	}
+	if !s.allRows && (s.where == nil || len(s.where.Conditions) == 0) {
+		return nil, fmt.Errorf("refusing to write DELETE without WHERE clause, use AllRows() to delete every row")
+	}
+
+	// Write DELETE FROM, an aliased table is written with its alias as the columns are prefixed with it
+	table := d.QuoteIdentifier(s.table.GetTableSchema().GetName())
+	if alias := s.table.GetAlias(); alias != "" {
+		table += " AS " + d.QuoteIdentifier(alias)
+	}
+	if _, err := w.Write([]byte("DELETE FROM " + table)); err != nil {
+		return nil, fmt.Errorf("error writing DELETE FROM: %w", err)
+	}
+
+	// Write WHERE
+	if s.where != nil && len(s.where.Conditions) > 0 {
+		if _, err := w.Write([]byte(" WHERE ")); err != nil {
+			return nil, fmt.Errorf("error writing WHERE: %w", err)
+		}
+		whereArgs, err := s.where.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing WHERE clause: %w", err)
+		}
+		args = append(args, whereArgs...)
+	}
+
+	// Write RETURNING
+	if s.returning != nil {
+		if _, err := w.Write([]byte(" RETURNING ")); err != nil {
+			return nil, fmt.Errorf("error writing RETURNING: %w", err)
+		}
+		returningArgs, err := s.returning.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing RETURNING clause: %w", err)
+		}
+		args = append(args, returningArgs...)
+	}
+
+	// Write ORDER BY
+	if s.orderBy != nil {
+		if _, err := w.Write([]byte(" ORDER BY ")); err != nil {
+			return nil, fmt.Errorf("error writing ORDER BY: %w", err)
+		}
+		orderArgs, err := s.orderBy.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing ORDER BY clause: %w", err)
+		}
+		args = append(args, orderArgs...)
+	}
+
+	// Write LIMIT and OFFSET
+	if s.limitOffset != nil {
+		limitOffsetArgs, err := s.limitOffset.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing LIMIT/OFFSET clause: %w", err)
+		}
+		args = append(args, limitOffsetArgs...)
+	}
+
+	return args, nil
+}
+
+// ScanReturning scans the row returned by the RETURNING clause back into the returned columns and closes rows.
+// Statements that return multiple rows must be mapped with db.Collect instead.
+func (s *DeleteStmt) ScanReturning(rows *sql.Rows) error {
+	if s.returning == nil {
+		return fmt.Errorf("statement has no RETURNING clause")
+	}
+	return s.returning.Scan(rows)
+}
+
+// ResultColumns returns the columns of the RETURNING clause, the returned rows are scanned into them
+func (s *DeleteStmt) ResultColumns() []schema.Column {
+	if s.returning == nil {
+		return nil
+	}
+	return s.returning.Columns
+}
+
+// ToSql converts the statement to SQL string with arguments (for testing)
+func (s *DeleteStmt) ToSql() (string, []any) {
+	ctx := context.Background()
+	w := &bytes.Buffer{}
+	args, _ := s.WriteSql(ctx, w, s.dialect, 1)
+	return w.String(), args
+}
@@ -0,0 +1,44 @@
+package sqlite
+
+import "testing"
+
+func TestDelete(t *testing.T) {
+	u := NewTable[testUser]()
+	al := Alias[testUser]("al")
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "delete with WHERE",
+			stmt: Delete(u, Where(u.ID.Eq(1))),
+			sql:  `DELETE FROM "users" WHERE "users"."id" = ?`,
+			args: []any{int64(1)},
+		},
+		{
+			name: "delete without WHERE",
+			stmt: Delete(u),
+			err:  "refusing to write DELETE without WHERE clause",
+		},
+		{
+			name: "delete with empty WHERE",
+			stmt: Delete(u, Where()),
+			err:  "refusing to write DELETE without WHERE clause",
+		},
+		{
+			name: "delete of all rows",
+			stmt: Delete(u, AllRows()),
+			sql:  `DELETE FROM "users"`,
+		},
+		{
+			name: "returning",
+			stmt: Delete(u, Where(u.Age.Lt(18)), Returning(&u.ID)),
+			sql:  `DELETE FROM "users" WHERE "users"."age" < ? RETURNING "id"`,
+			args: []any{int64(18)},
+		},
+		{
+			name: "delete from an aliased table",
+			stmt: Delete(al, Where(al.ID.Eq(1))),
+			sql:  `DELETE FROM "users" AS "al" WHERE "al"."id" = ?`,
+			args: []any{int64(1)},
+		},
+	})
+}
@@ -1,6 +1,9 @@
 package sqlite
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // SqliteDialect implements the dialect.SqliteDialect interface for SQLite
 type SqliteDialect struct{}
@@ -31,18 +34,46 @@ func (d *SqliteDialect) SupportsReturning() bool {
 	return true
 }
 
+// SupportsDistinctOn returns false as SQLite doesn't support DISTINCT ON
+func (d *SqliteDialect) SupportsDistinctOn() bool {
+	return false
+}
+
 // LimitOffset returns the SQL for LIMIT and OFFSET clauses
-func (d *SqliteDialect) LimitOffset(limit, offset *int) string {
-	if limit == nil && offset == nil {
+func (d *SqliteDialect) LimitOffset(limit, offset string) string {
+	if limit == "" && offset == "" {
 		return ""
 	}
 
-	sql := ""
-	if limit != nil {
-		sql = fmt.Sprintf(" LIMIT %d", *limit)
+	// SQLite doesn't allow OFFSET without LIMIT, a negative limit means no limit
+	if limit == "" {
+		limit = "-1"
 	}
-	if offset != nil {
-		sql += fmt.Sprintf(" OFFSET %d", *offset)
+
+	sql := fmt.Sprintf(" LIMIT %s", limit)
+	if offset != "" {
+		sql += fmt.Sprintf(" OFFSET %s", offset)
 	}
 	return sql
 }
+
+// OnConflict returns the SQL for the ON CONFLICT clause of an upsert
+func (d *SqliteDialect) OnConflict(columns []string, doNothing bool) string {
+	sql := " ON CONFLICT"
+	if len(columns) > 0 {
+		quoted := make([]string, len(columns))
+		for i, column := range columns {
+			quoted[i] = d.QuoteIdentifier(column)
+		}
+		sql += " (" + strings.Join(quoted, ", ") + ")"
+	}
+	if doNothing {
+		return sql + " DO NOTHING"
+	}
+	return sql + " DO UPDATE SET "
+}
+
+// Excluded returns the reference to a column of the excluded pseudo-table
+func (d *SqliteDialect) Excluded(column string) string {
+	return "excluded." + d.QuoteIdentifier(column)
+}
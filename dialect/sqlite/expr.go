@@ -0,0 +1,26 @@
+package sqlite
+
+import (
+	"github.com/gogo-framework/db/internal/query"
+)
+
+// ExpressionColumn represents a selected expression in SQLite, e.g. a CASE or arithmetic expression
+type ExpressionColumn struct {
+	*query.ExpressionColumn
+}
+
+// ApplySelect implements the SelectPart interface
+func (e *ExpressionColumn) ApplySelect(stmt *SelectStmt) {
+	if stmt.Columns == nil {
+		stmt.Columns = &SelectClause{
+			SelectClause: &query.SelectClause{},
+		}
+	}
+	stmt.Columns.Columns = append(stmt.Columns.Columns, e.ExpressionColumn)
+}
+
+// As creates an alias for the expression
+func (e *ExpressionColumn) As(name string) *ExpressionColumn {
+	e.Alias = name
+	return e
+}
@@ -20,6 +20,12 @@ func (f *Function) ApplySelect(stmt *SelectStmt) {
 	stmt.Columns.Columns = append(stmt.Columns.Columns, f.Function)
 }
 
+// As creates an alias for the function, the result is mapped back by this name
+func (f *Function) As(name string) *Function {
+	f.Alias = name
+	return f
+}
+
 // String functions
 
 // Upper creates an UPPER function for SQLite
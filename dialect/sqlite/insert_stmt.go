@@ -0,0 +1,119 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+
+	"github.com/gogo-framework/db/dialect"
+	"github.com/gogo-framework/db/internal/query"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// InsertPart represents a part of an INSERT statement that can be applied to an InsertStmt
+type InsertPart interface {
+	ApplyInsert(*InsertStmt)
+}
+
+// InsertStmt represents a SQLite INSERT statement
+type InsertStmt struct {
+	// can be removed later, right now it's used in the toSql method but this is only for testing
+	dialect    dialect.Dialect
+	table      schema.Table
+	values     *query.ValuesClause
+	onConflict *query.OnConflictClause
+	returning  *query.ReturningClause
+}
+
+// Insert creates a new SQLite INSERT statement for the given table
+func Insert(table schema.Table, parts ...InsertPart) *InsertStmt {
+	stmt := &InsertStmt{
+		dialect: &SqliteDialect{},
+		table:   table,
+		values:  &query.ValuesClause{},
+	}
+	for _, part := range parts {
+		if part != nil {
+			part.ApplyInsert(stmt)
+		}
+	}
+	return stmt
+}
+
+// Values adds a row to the statement, every call adds another row to the VALUES clause.
+// The values are taken from the columns through their driver.Valuer implementation.
+func (s *InsertStmt) Values(columns ...schema.Column) *InsertStmt {
+	s.values.AppendRow(columns...)
+	return s
+}
+
+// WriteSql generates the SQL for the INSERT statement
+func (s *InsertStmt) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	var args []any
+
+	if s.table == nil {
+		return nil, fmt.Errorf("no table to insert into")
+	}
+
+	// Write INSERT INTO
+	if _, err := w.Write([]byte("INSERT INTO " + d.QuoteIdentifier(s.table.GetTableSchema().GetName()) + " ")); err != nil {
+		return nil, fmt.Errorf("error writing INSERT INTO: %w", err)
+	}
+
+	// Write columns and VALUES
+	valuesArgs, err := s.values.WriteSql(ctx, w, d, argPos+len(args))
+	if err != nil {
+		return nil, fmt.Errorf("error writing VALUES clause: %w", err)
+	}
+	args = append(args, valuesArgs...)
+
+	// Write ON CONFLICT
+	if s.onConflict != nil {
+		onConflictArgs, err := s.onConflict.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing ON CONFLICT clause: %w", err)
+		}
+		args = append(args, onConflictArgs...)
+	}
+
+	// Write RETURNING
+	if s.returning != nil {
+		if _, err := w.Write([]byte(" RETURNING ")); err != nil {
+			return nil, fmt.Errorf("error writing RETURNING: %w", err)
+		}
+		returningArgs, err := s.returning.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing RETURNING clause: %w", err)
+		}
+		args = append(args, returningArgs...)
+	}
+
+	return args, nil
+}
+
+// ScanReturning scans the row returned by the RETURNING clause back into the returned columns and closes rows.
+// Statements that return multiple rows must be mapped with db.Collect instead.
+func (s *InsertStmt) ScanReturning(rows *sql.Rows) error {
+	if s.returning == nil {
+		return fmt.Errorf("statement has no RETURNING clause")
+	}
+	return s.returning.Scan(rows)
+}
+
+// ResultColumns returns the columns of the RETURNING clause, the returned rows are scanned into them
+func (s *InsertStmt) ResultColumns() []schema.Column {
+	if s.returning == nil {
+		return nil
+	}
+	return s.returning.Columns
+}
+
+// ToSql converts the statement to SQL string with arguments (for testing)
+func (s *InsertStmt) ToSql() (string, []any) {
+	ctx := context.Background()
+	w := &bytes.Buffer{}
+	args, _ := s.WriteSql(ctx, w, s.dialect, 1)
+	return w.String(), args
+}
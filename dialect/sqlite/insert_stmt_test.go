@@ -0,0 +1,85 @@
+package sqlite
+
+import "testing"
+
+func TestInsert(t *testing.T) {
+	u := NewTable[testUser]()
+	u.Name.Set("alice")
+	u.Age.Set(30)
+
+	other := NewTable[testUser]()
+	other.Name.Set("bob")
+	other.Age.Set(25)
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "single row",
+			stmt: Insert(u).Values(&u.Name, &u.Age),
+			sql:  `INSERT INTO "users" ("name", "age") VALUES (?, ?)`,
+			args: []any{"alice", int64(30)},
+		},
+		{
+			name: "multiple rows",
+			stmt: Insert(u).Values(&u.Name, &u.Age).Values(&other.Name, &other.Age),
+			sql:  `INSERT INTO "users" ("name", "age") VALUES (?, ?), (?, ?)`,
+			args: []any{"alice", int64(30), "bob", int64(25)},
+		},
+		{
+			name: "unset nullable column",
+			stmt: Insert(u).Values(&u.Name, &u.Email),
+			sql:  `INSERT INTO "users" ("name", "email") VALUES (?, ?)`,
+			args: []any{"alice", nil},
+		},
+		{
+			name: "rows with different columns",
+			stmt: Insert(u).Values(&u.Name, &u.Age).Values(&other.Age, &other.Name),
+			err:  "row 1 has column age at position 0, expected name",
+		},
+		{
+			name: "rows with a different number of columns",
+			stmt: Insert(u).Values(&u.Name, &u.Age).Values(&other.Name),
+			err:  "row 1 has 1 values, expected 2",
+		},
+		{
+			name: "no values",
+			stmt: Insert(u),
+			err:  "no values to insert",
+		},
+		{
+			name: "returning",
+			stmt: Insert(u, Returning(&u.ID)).Values(&u.Name, &u.Age),
+			sql:  `INSERT INTO "users" ("name", "age") VALUES (?, ?) RETURNING "id"`,
+			args: []any{"alice", int64(30)},
+		},
+	})
+}
+
+func TestInsertOnConflict(t *testing.T) {
+	u := NewTable[testUser]()
+	u.Email.Set("alice@example.com")
+	u.Score.Set(10)
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "do nothing",
+			stmt: Insert(u, OnConflict(&u.Email).DoNothing()).Values(&u.Email, &u.Score),
+			sql:  `INSERT INTO "users" ("email", "score") VALUES (?, ?) ON CONFLICT ("email") DO NOTHING`,
+			args: []any{"alice@example.com", 10.0},
+		},
+		{
+			name: "do update with excluded values",
+			stmt: Insert(u, OnConflict(&u.Email).DoUpdateSet(Set(&u.Score, Excluded(&u.Score)))).Values(&u.Email, &u.Score),
+			sql:  `INSERT INTO "users" ("email", "score") VALUES (?, ?) ON CONFLICT ("email") DO UPDATE SET "score" = excluded."score"`,
+			args: []any{"alice@example.com", 10.0},
+		},
+		{
+			name: "do update with a condition",
+			stmt: Insert(u, OnConflict(&u.Email).
+				DoUpdateSet(Set(&u.Score, Add(Excluded(&u.Score), &u.Score))).
+				Where(Excluded(&u.Score).Gt(0))).
+				Values(&u.Email, &u.Score),
+			sql:  `INSERT INTO "users" ("email", "score") VALUES (?, ?) ON CONFLICT ("email") DO UPDATE SET "score" = excluded."score" + "users"."score" WHERE excluded."score" > ?`,
+			args: []any{"alice@example.com", 10.0, 0.0},
+		},
+	})
+}
@@ -0,0 +1,87 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gogo-framework/db/internal/query"
+)
+
+type testUser struct {
+	BaseTable
+	ID        Integer
+	Name      Text
+	Email     NullText
+	Age       Integer
+	Score     Float
+	Flags     Integer
+	ManagerID NullInteger
+}
+
+func (u *testUser) ConfigureSchema(ts *TableSchema) {
+	ts.SetName("users")
+	ts.RegisterColumn("id", &u.ID)
+	ts.RegisterColumn("name", &u.Name)
+	ts.RegisterColumn("email", &u.Email)
+	ts.RegisterColumn("age", &u.Age)
+	ts.RegisterColumn("score", &u.Score)
+	ts.RegisterColumn("flags", &u.Flags)
+	ts.RegisterColumn("manager_id", &u.ManagerID)
+}
+
+type testPost struct {
+	BaseTable
+	ID     Integer
+	UserID Integer
+	Title  Text
+}
+
+func (p *testPost) ConfigureSchema(ts *TableSchema) {
+	ts.SetName("posts")
+	ts.RegisterColumn("id", &p.ID)
+	ts.RegisterColumn("user_id", &p.UserID)
+	ts.RegisterColumn("title", &p.Title)
+}
+
+// renderTest is a statement with the SQL and arguments it must render to,
+// or with a part of the error message if rendering must fail
+type renderTest struct {
+	name string
+	stmt query.Expression
+	sql  string
+	args []any
+	err  string
+}
+
+func runRenderTests(t *testing.T, tests []renderTest) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &bytes.Buffer{}
+			args, err := tt.stmt.WriteSql(context.Background(), w, &SqliteDialect{}, 1)
+			if tt.err != "" {
+				if err == nil {
+					t.Fatalf("expected an error containing %q, got SQL %s", tt.err, w.String())
+				}
+				if !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("got error %q, want an error containing %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if w.String() != tt.sql {
+				t.Errorf("got SQL\n\t%s\nwant\n\t%s", w.String(), tt.sql)
+			}
+			if len(args) != 0 || len(tt.args) != 0 {
+				if !reflect.DeepEqual(args, tt.args) {
+					t.Errorf("got args %#v, want %#v", args, tt.args)
+				}
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/gogo-framework/db/dialect"
 	"github.com/gogo-framework/db/internal/query"
+	"github.com/gogo-framework/db/internal/schema"
 )
 
 // SelectPart represents a part of a SELECT statement that can be applied to a SelectStmt
@@ -19,12 +20,14 @@ type SelectPart interface {
 type SelectStmt struct {
 	// can be removed later, right now it's used in the toSql method but this is only for testing
 	dialect     dialect.Dialect
+	with        *query.WithClause
 	Columns     *SelectClause
 	distinct    *DistinctClause
 	from        *FromClause
 	where       *WhereClause
 	groupBy     *GroupByClause
 	having      *HavingClause
+	windows     *query.WindowClause
 	orderBy     *OrderByClause
 	limitOffset *query.LimitOffsetClause
 }
@@ -33,10 +36,20 @@ type SelectStmt struct {
 func (s *SelectStmt) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
 	var args []any
 
+	// Write WITH
+	if s.with != nil {
+		ctx = s.with.Scope(ctx)
+		withArgs, err := s.with.WriteSql(ctx, w, d, argPos)
+		if err != nil {
+			return nil, fmt.Errorf("error writing WITH clause: %w", err)
+		}
+		args = append(args, withArgs...)
+	}
+
 	// Write SELECT
 	w.Write([]byte("SELECT "))
 	if s.distinct != nil {
-		distinctArgs, err := s.distinct.WriteSql(ctx, w, d, argPos)
+		distinctArgs, err := s.distinct.WriteSql(ctx, w, d, argPos+len(args))
 		if err != nil {
 			return nil, fmt.Errorf("error writing DISTINCT: %w", err)
 		}
@@ -102,6 +115,18 @@ func (s *SelectStmt) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialec
 		args = append(args, havingArgs...)
 	}
 
+	// Write WINDOW
+	if s.windows != nil {
+		if _, err := w.Write([]byte(" WINDOW ")); err != nil {
+			return nil, fmt.Errorf("error writing WINDOW: %w", err)
+		}
+		windowArgs, err := s.windows.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing WINDOW clause: %w", err)
+		}
+		args = append(args, windowArgs...)
+	}
+
 	// Write ORDER BY
 	if s.orderBy != nil {
 		if _, err := w.Write([]byte(" ORDER BY ")); err != nil {
@@ -116,9 +141,6 @@ func (s *SelectStmt) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialec
 
 	// Write LIMIT and OFFSET
 	if s.limitOffset != nil {
-		if _, err := w.Write([]byte(" ")); err != nil {
-			return nil, fmt.Errorf("error writing LIMIT/OFFSET: %w", err)
-		}
 		limitOffsetArgs, err := s.limitOffset.WriteSql(ctx, w, d, argPos+len(args))
 		if err != nil {
 			return nil, fmt.Errorf("error writing LIMIT/OFFSET clause: %w", err)
@@ -129,6 +151,14 @@ func (s *SelectStmt) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialec
 	return args, nil
 }
 
+// ResultColumns returns the selected columns, the rows of the statement are scanned into them
+func (s *SelectStmt) ResultColumns() []schema.Column {
+	if s.Columns == nil {
+		return nil
+	}
+	return s.Columns.Columns
+}
+
 // ToSql converts the statement to SQL string with arguments (for testing)
 func (s *SelectStmt) ToSql() (string, []any) {
 	ctx := context.Background()
@@ -136,3 +166,39 @@ func (s *SelectStmt) ToSql() (string, []any) {
 	args, _ := s.WriteSql(ctx, w, s.dialect, 1)
 	return w.String(), args
 }
+
+// AsSubquery implements the query.Statement interface, so the statement can be used as an operand of a condition
+func (s *SelectStmt) AsSubquery() *query.Subquery {
+	return query.NewSubquery(s)
+}
+
+// SubqueryExpr represents a statement between parentheses,
+// it's used as a scalar expression, as the source of an IN condition or, given an alias, as a derived table.
+type SubqueryExpr struct {
+	*query.Subquery
+}
+
+// As sets the alias of the derived table, its columns are written as "alias"."column"
+func (s *SubqueryExpr) As(alias string) *SubqueryExpr {
+	s.SetAlias(alias)
+	return s
+}
+
+// Column declares a typed column of the derived table,
+// the column can then be selected and used in conditions of the outer query like any other table column.
+func (s *SubqueryExpr) Column(name string, column schema.Column) *SubqueryExpr {
+	s.RegisterColumn(name, column)
+	return s
+}
+
+// Subquery wraps a SELECT or compound statement so it can be used as a derived table.
+// Statements that are used as operands of conditions, e.g. Eq(&u.ID, Select(...)), are wrapped automatically.
+//
+//	id := &Integer{}
+//	active := Subquery(Select(&u.ID, From(u), Where(u.Active.Eq(true)))).As("active").Column("id", id)
+//	Select(id, From(active))
+func Subquery(stmt query.Statement) *SubqueryExpr {
+	return &SubqueryExpr{
+		Subquery: stmt.AsSubquery(),
+	}
+}
@@ -0,0 +1,226 @@
+package sqlite
+
+import "testing"
+
+func TestSelectSubqueries(t *testing.T) {
+	u := NewTable[testUser]()
+	p := NewTable[testPost]()
+
+	id := &Integer{}
+	name := &Text{}
+	adults := Subquery(Select(&u.ID, &u.Name, From(u), Where(u.Age.Gte(18)))).
+		As("adults").
+		Column("id", id).
+		Column("name", name)
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "statement as comparison operand",
+			stmt: Select(&p.Title, From(p), Where(Eq(&p.UserID, Select(&u.ID, From(u), Where(u.Name.Eq("alice")))))),
+			sql:  `SELECT "posts"."title" FROM "posts" WHERE "posts"."user_id" = (SELECT "users"."id" FROM "users" WHERE "users"."name" = ?)`,
+			args: []any{"alice"},
+		},
+		{
+			name: "statement as IN source",
+			stmt: Select(&u.Name, From(u), Where(In(&u.ID, Select(&p.UserID, From(p))))),
+			sql:  `SELECT "users"."name" FROM "users" WHERE "users"."id" IN (SELECT "posts"."user_id" FROM "posts")`,
+		},
+		{
+			name: "subquery as IN source",
+			stmt: Select(&u.Name, From(u), Where(NotIn(&u.ID, Subquery(Select(&p.UserID, From(p)))))),
+			sql:  `SELECT "users"."name" FROM "users" WHERE "users"."id" NOT IN (SELECT "posts"."user_id" FROM "posts")`,
+		},
+		{
+			name: "EXISTS with a statement",
+			stmt: Select(&u.Name, From(u), Where(Exists(Select(&p.ID, From(p), Where(Eq(&p.UserID, &u.ID)))))),
+			sql:  `SELECT "users"."name" FROM "users" WHERE EXISTS (SELECT "posts"."id" FROM "posts" WHERE "posts"."user_id" = "users"."id")`,
+		},
+		{
+			name: "EXISTS with a subquery",
+			stmt: Select(&u.Name, From(u), Where(Not(Exists(Subquery(Select(&p.ID, From(p))))))),
+			sql:  `SELECT "users"."name" FROM "users" WHERE NOT (EXISTS (SELECT "posts"."id" FROM "posts"))`,
+		},
+		{
+			name: "derived table with declared columns",
+			stmt: Select(id, name, From(adults), Where(name.Like("a%"))),
+			sql:  `SELECT "adults"."id", "adults"."name" FROM (SELECT "users"."id", "users"."name" FROM "users" WHERE "users"."age" >= ?) AS "adults" WHERE "adults"."name" LIKE ?`,
+			args: []any{int64(18), "a%"},
+		},
+	})
+}
+
+func TestSelectJoins(t *testing.T) {
+	u := NewTable[testUser]()
+	p := NewTable[testPost]()
+	e := Alias[testUser]("e")
+	m := Alias[testUser]("m")
+
+	// A FROM clause that's shared by statements must not collect the joins of each of them
+	from := From(u)
+	withPosts := Select(&u.Name, &p.Title, from, InnerJoin(p).On(Eq(&p.UserID, &u.ID)))
+	withoutPosts := Select(&u.Name, from)
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "inner join",
+			stmt: withPosts,
+			sql:  `SELECT "users"."name", "posts"."title" FROM "users" INNER JOIN "posts" ON "posts"."user_id" = "users"."id"`,
+		},
+		{
+			name: "shared FROM clause without the join",
+			stmt: withoutPosts,
+			sql:  `SELECT "users"."name" FROM "users"`,
+		},
+		{
+			name: "join before FROM",
+			stmt: Select(&u.Name, LeftJoin(p).On(Eq(&p.UserID, &u.ID)), From(u)),
+			sql:  `SELECT "users"."name" FROM "users" LEFT JOIN "posts" ON "posts"."user_id" = "users"."id"`,
+		},
+		{
+			name: "self-join of aliased instances",
+			stmt: Select(&e.Name, &m.Name, From(e), LeftJoin(m).On(Eq(&e.ManagerID, &m.ID))),
+			sql:  `SELECT "e"."name", "m"."name" FROM "users" AS "e" LEFT JOIN "users" AS "m" ON "e"."manager_id" = "m"."id"`,
+		},
+		{
+			name: "cross join without conditions",
+			stmt: Select(&u.Name, &p.Title, From(u), CrossJoin(p)),
+			sql:  `SELECT "users"."name", "posts"."title" FROM "users" CROSS JOIN "posts"`,
+		},
+		{
+			name: "cross join with conditions",
+			stmt: Select(&u.Name, From(u), CrossJoin(p).On(Eq(&p.UserID, &u.ID))),
+			err:  "CROSS JOIN does not accept ON conditions",
+		},
+	})
+}
+
+func TestSelectOutputNames(t *testing.T) {
+	u := NewTable[testUser]()
+
+	// Results that are scanned into columns outside of a table get a derived name,
+	// so they can still be mapped back by name
+	var label Text
+	var total Float
+	var rank Integer
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "CASE into an unregistered column",
+			stmt: Select(Case().When(u.Age.Lt(18), "minor").Else("adult").Into(&label), From(u)),
+			sql:  `SELECT CASE WHEN "users"."age" < ? THEN ? ELSE ? END AS "case" FROM "users"`,
+			args: []any{int64(18), "minor", "adult"},
+		},
+		{
+			name: "arithmetic into an unregistered column",
+			stmt: Select(Add(&u.Score, 1.5).Into(&total), From(u)),
+			sql:  `SELECT "users"."score" + ? AS "expr" FROM "users"`,
+			args: []any{1.5},
+		},
+		{
+			name: "arithmetic into a registered column",
+			stmt: Select(Mul(&u.Score, 2).Into(&u.Score), From(u)),
+			sql:  `SELECT "users"."score" * ? AS "score" FROM "users"`,
+			args: []any{2},
+		},
+		{
+			name: "window function into an unregistered column",
+			stmt: Select(RowNumber(&rank).Over(OrderBy(&u.ID)), Sum(&u.Score, &total).Over(OrderBy(&u.ID)), From(u)),
+			sql:  `SELECT ROW_NUMBER() OVER (ORDER BY "users"."id") AS "row_number", SUM("users"."score") OVER (ORDER BY "users"."id") AS "sum_score" FROM "users"`,
+		},
+		{
+			name: "aggregation into an unregistered column",
+			stmt: Select(CountAll(&rank), Avg(&u.Age, &total), From(u)),
+			sql:  `SELECT COUNT(*) AS "count_all", AVG("users"."age") AS "avg_age" FROM "users"`,
+		},
+	})
+}
+
+func TestSelectOrderBy(t *testing.T) {
+	u := NewTable[testUser]()
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "directions",
+			stmt: Select(&u.ID, From(u), OrderBy(Asc(&u.Name), Desc(&u.Age), &u.ID)),
+			sql:  `SELECT "users"."id" FROM "users" ORDER BY "users"."name" ASC, "users"."age" DESC, "users"."id"`,
+		},
+		{
+			name: "NULLS FIRST and LAST",
+			stmt: Select(&u.ID, From(u), OrderBy(NullsFirst(Desc(&u.Email)), NullsLast(&u.ManagerID))),
+			sql:  `SELECT "users"."id" FROM "users" ORDER BY "users"."email" DESC NULLS FIRST, "users"."manager_id" NULLS LAST`,
+		},
+		{
+			name: "collation",
+			stmt: Select(&u.ID, From(u), OrderBy(Asc(Collate(&u.Name, "NOCASE")))),
+			sql:  `SELECT "users"."id" FROM "users" ORDER BY "users"."name" COLLATE "NOCASE" ASC`,
+		},
+	})
+}
+
+func TestSelectLimitOffset(t *testing.T) {
+	u := NewTable[testUser]()
+
+	// A shared part is merged into a copy, so the offset of one statement doesn't leak into the other
+	page := Limit(20)
+	first := Select(&u.ID, From(u), page)
+	second := Select(&u.ID, From(u), page, Offset(20))
+
+	offset := 5
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "limit",
+			stmt: first,
+			sql:  `SELECT "users"."id" FROM "users" LIMIT ?`,
+			args: []any{20},
+		},
+		{
+			name: "limit merged with an offset",
+			stmt: second,
+			sql:  `SELECT "users"."id" FROM "users" LIMIT ? OFFSET ?`,
+			args: []any{20, 20},
+		},
+		{
+			name: "offset without a limit",
+			stmt: Select(&u.ID, From(u), LimitOffset(nil, &offset)),
+			sql:  `SELECT "users"."id" FROM "users" LIMIT -1 OFFSET ?`,
+			args: []any{5},
+		},
+		{
+			name: "inline",
+			stmt: Select(&u.ID, From(u), Where(u.Age.Gt(18)), Limit(10).Inline(), Offset(30)),
+			sql:  `SELECT "users"."id" FROM "users" WHERE "users"."age" > ? LIMIT 10 OFFSET 30`,
+			args: []any{int64(18)},
+		},
+	})
+}
+
+func TestSelectDistinct(t *testing.T) {
+	u := NewTable[testUser]()
+
+	var count Integer
+	var total Float
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "DISTINCT",
+			stmt: Select(&u.Name, Distinct(), From(u)),
+			sql:  `SELECT DISTINCT "users"."name" FROM "users"`,
+		},
+		{
+			name: "DISTINCT ON",
+			stmt: Select(&u.Name, Distinct().On(&u.Age), From(u)),
+			err:  "DISTINCT ON is not supported",
+		},
+		{
+			name: "distinct aggregates",
+			stmt: Select(CountDistinct(&u.Name, &count), Sum(&u.Score, &total).Distinct(), From(u)),
+			sql:  `SELECT COUNT(DISTINCT "users"."name") AS "count_distinct_name", SUM(DISTINCT "users"."score") AS "sum_distinct_score" FROM "users"`,
+		},
+		{
+			name: "distinct COUNT(*)",
+			stmt: Select(CountAll(&count).Distinct(), From(u)),
+			err:  "DISTINCT can't be used with COUNT(*)",
+		},
+	})
+}
@@ -2,8 +2,48 @@ package sqlite
 
 import "github.com/gogo-framework/db/internal/schema"
 
-func NewTable(name string, setup func(t *schema.Table)) *schema.Table {
-	t := &schema.Table{Name: name}
-	setup(t)
-	return t
+// BaseTable implements the Table interface except ConfigureSchema, it should be embedded in every table type:
+//
+//	type User struct {
+//		sqlite.BaseTable
+//		ID   sqlite.Integer
+//		Name sqlite.Text
+//	}
+//
+//	func (u *User) ConfigureSchema(ts *sqlite.TableSchema) {
+//		ts.SetName("users")
+//		ts.RegisterColumn("id", &u.ID)
+//		ts.RegisterColumn("name", &u.Name)
+//	}
+type BaseTable = schema.BaseTable
+
+// TableSchema is the schema of a table, it's configured in the ConfigureSchema method of the table type
+type TableSchema = schema.TableSchema
+
+// Table is implemented by table types and can be used in FROM, joins and as the target of statements
+type Table = schema.Table
+
+// NewTable creates a new instance of the table type T, see schema.NewTable
+func NewTable[T any, PT interface {
+	*T
+	schema.Table
+	schema.TableConfigurer
+}]() PT {
+	return schema.NewTable[T, PT]()
+}
+
+// Alias creates a new, independently aliased instance of the table type T.
+// This allows the same table to be used multiple times in one query, e.g. for self-joins:
+//
+//	e := sqlite.Alias[Employee]("e")
+//	m := sqlite.Alias[Employee]("m")
+//	sqlite.Select(&e.Name, &m.Name, sqlite.From(e), sqlite.CrossJoin(m))
+//
+// Columns of e are written as "e"."column" and columns of m as "m"."column".
+func Alias[T any, PT interface {
+	*T
+	schema.Table
+	schema.TableConfigurer
+}](alias string) PT {
+	return schema.Alias[T, PT](alias)
 }
@@ -0,0 +1,151 @@
+package sqlite
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+
+	"github.com/gogo-framework/db/dialect"
+	"github.com/gogo-framework/db/internal/query"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// UpdatePart represents a part of an UPDATE statement that can be applied to an UpdateStmt
+type UpdatePart interface {
+	ApplyUpdate(*UpdateStmt)
+}
+
+// UpdateStmt represents a SQLite UPDATE statement
+type UpdateStmt struct {
+	// can be removed later, right now it's used in the toSql method but this is only for testing
+	dialect     dialect.Dialect
+	table       schema.Table
+	set         *query.SetClause
+	where       *WhereClause
+	returning   *query.ReturningClause
+	orderBy     *OrderByClause
+	limitOffset *query.LimitOffsetClause
+	allRows     bool
+}
+
+// Update creates a new SQLite UPDATE statement for the given table
+func Update(table schema.Table, parts ...UpdatePart) *UpdateStmt {
+	stmt := &UpdateStmt{
+		dialect: &SqliteDialect{},
+		table:   table,
+	}
+	for _, part := range parts {
+		if part != nil {
+			part.ApplyUpdate(stmt)
+		}
+	}
+	return stmt
+}
+
+// WriteSql generates the SQL for the UPDATE statement
+func (s *UpdateStmt) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	var args []any
+
+	if s.table == nil {
+		return nil, fmt.Errorf("no table to update")
+	}
+	if !s.allRows && (s.where == nil || len(s.where.Conditions) == 0) {
+		return nil, fmt.Errorf("refusing to write UPDATE without WHERE clause, use AllRows() to update every row")
+	}
+	if s.set == nil {
+		return nil, fmt.Errorf("no columns to set")
+	}
+
+	// Write UPDATE, an aliased table is written with its alias as the columns are prefixed with it
+	table := d.QuoteIdentifier(s.table.GetTableSchema().GetName())
+	if alias := s.table.GetAlias(); alias != "" {
+		table += " AS " + d.QuoteIdentifier(alias)
+	}
+	if _, err := w.Write([]byte("UPDATE " + table)); err != nil {
+		return nil, fmt.Errorf("error writing UPDATE: %w", err)
+	}
+
+	// Write SET
+	if _, err := w.Write([]byte(" SET ")); err != nil {
+		return nil, fmt.Errorf("error writing SET: %w", err)
+	}
+	setArgs, err := s.set.WriteSql(ctx, w, d, argPos+len(args))
+	if err != nil {
+		return nil, fmt.Errorf("error writing SET clause: %w", err)
+	}
+	args = append(args, setArgs...)
+
+	// Write WHERE
+	if s.where != nil && len(s.where.Conditions) > 0 {
+		if _, err := w.Write([]byte(" WHERE ")); err != nil {
+			return nil, fmt.Errorf("error writing WHERE: %w", err)
+		}
+		whereArgs, err := s.where.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing WHERE clause: %w", err)
+		}
+		args = append(args, whereArgs...)
+	}
+
+	// Write RETURNING
+	if s.returning != nil {
+		if _, err := w.Write([]byte(" RETURNING ")); err != nil {
+			return nil, fmt.Errorf("error writing RETURNING: %w", err)
+		}
+		returningArgs, err := s.returning.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing RETURNING clause: %w", err)
+		}
+		args = append(args, returningArgs...)
+	}
+
+	// Write ORDER BY
+	if s.orderBy != nil {
+		if _, err := w.Write([]byte(" ORDER BY ")); err != nil {
+			return nil, fmt.Errorf("error writing ORDER BY: %w", err)
+		}
+		orderArgs, err := s.orderBy.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing ORDER BY clause: %w", err)
+		}
+		args = append(args, orderArgs...)
+	}
+
+	// Write LIMIT and OFFSET
+	if s.limitOffset != nil {
+		limitOffsetArgs, err := s.limitOffset.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing LIMIT/OFFSET clause: %w", err)
+		}
+		args = append(args, limitOffsetArgs...)
+	}
+
+	return args, nil
+}
+
+// ScanReturning scans the row returned by the RETURNING clause back into the returned columns and closes rows.
+// Statements that return multiple rows must be mapped with db.Collect instead.
+func (s *UpdateStmt) ScanReturning(rows *sql.Rows) error {
+	if s.returning == nil {
+		return fmt.Errorf("statement has no RETURNING clause")
+	}
+	return s.returning.Scan(rows)
+}
+
+// ResultColumns returns the columns of the RETURNING clause, the returned rows are scanned into them
+func (s *UpdateStmt) ResultColumns() []schema.Column {
+	if s.returning == nil {
+		return nil
+	}
+	return s.returning.Columns
+}
+
+// ToSql converts the statement to SQL string with arguments (for testing)
+func (s *UpdateStmt) ToSql() (string, []any) {
+	ctx := context.Background()
+	w := &bytes.Buffer{}
+	args, _ := s.WriteSql(ctx, w, s.dialect, 1)
+	return w.String(), args
+}
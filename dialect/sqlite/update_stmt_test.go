@@ -0,0 +1,64 @@
+package sqlite
+
+import "testing"
+
+func TestUpdate(t *testing.T) {
+	u := NewTable[testUser]()
+	p := NewTable[testPost]()
+	al := Alias[testUser]("al")
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "update with WHERE",
+			stmt: Update(u, Set(&u.Name, "alice"), Where(u.ID.Eq(1))),
+			sql:  `UPDATE "users" SET "name" = ? WHERE "users"."id" = ?`,
+			args: []any{"alice", int64(1)},
+		},
+		{
+			name: "multiple assignments and conditions",
+			stmt: Update(u, Set(&u.Name, "alice"), Set(&u.Age, 31), Where(u.ID.Eq(1), u.Age.Lt(31))),
+			sql:  `UPDATE "users" SET "name" = ?, "age" = ? WHERE "users"."id" = ? AND "users"."age" < ?`,
+			args: []any{"alice", 31, int64(1), int64(31)},
+		},
+		{
+			name: "assignment of an expression",
+			stmt: Update(u, Set(&u.Age, Add(&u.Age, 1)), Where(u.ID.Eq(1))),
+			sql:  `UPDATE "users" SET "age" = "users"."age" + ? WHERE "users"."id" = ?`,
+			args: []any{1, int64(1)},
+		},
+		{
+			name: "assignment of a statement",
+			stmt: Update(u, Set(&u.Name, Select(&p.Title, From(p), Where(Eq(&p.UserID, &u.ID)), Limit(1))), Where(u.ID.Eq(1))),
+			sql:  `UPDATE "users" SET "name" = (SELECT "posts"."title" FROM "posts" WHERE "posts"."user_id" = "users"."id" LIMIT ?) WHERE "users"."id" = ?`,
+			args: []any{1, int64(1)},
+		},
+		{
+			name: "update without WHERE",
+			stmt: Update(u, Set(&u.Name, "alice")),
+			err:  "refusing to write UPDATE without WHERE clause",
+		},
+		{
+			name: "update of all rows",
+			stmt: Update(u, Set(&u.Score, 0.0), AllRows()),
+			sql:  `UPDATE "users" SET "score" = ?`,
+			args: []any{0.0},
+		},
+		{
+			name: "update without assignments",
+			stmt: Update(u, Where(u.ID.Eq(1))),
+			err:  "no columns to set",
+		},
+		{
+			name: "returning",
+			stmt: Update(u, Set(&u.Name, "alice"), Where(u.ID.Eq(1)), Returning(&u.ID, &u.Name)),
+			sql:  `UPDATE "users" SET "name" = ? WHERE "users"."id" = ? RETURNING "id", "name"`,
+			args: []any{"alice", int64(1)},
+		},
+		{
+			name: "update of an aliased table",
+			stmt: Update(al, Set(&al.Name, "alice"), Where(al.ID.Eq(1))),
+			sql:  `UPDATE "users" AS "al" SET "name" = ? WHERE "al"."id" = ?`,
+			args: []any{"alice", int64(1)},
+		},
+	})
+}
@@ -0,0 +1,207 @@
+package sqlite
+
+import (
+	"github.com/gogo-framework/db/internal/query"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// Frame bounds for Rows and Range
+const (
+	UnboundedPreceding = query.UnboundedPreceding
+	CurrentRow         = query.CurrentRow
+	UnboundedFollowing = query.UnboundedFollowing
+)
+
+// Preceding creates a frame bound n rows before the current row
+func Preceding(n int) query.FrameBound {
+	return query.Preceding(n)
+}
+
+// Following creates a frame bound n rows after the current row
+func Following(n int) query.FrameBound {
+	return query.Following(n)
+}
+
+// WindowPart represents a part of a window definition
+type WindowPart interface {
+	ApplyWindow(*query.WindowSpec)
+}
+
+func newWindowSpec(parts ...WindowPart) *query.WindowSpec {
+	spec := &query.WindowSpec{}
+	for _, part := range parts {
+		if part != nil {
+			part.ApplyWindow(spec)
+		}
+	}
+	return spec
+}
+
+// PartitionByClause represents the PARTITION BY part of a window
+type PartitionByClause struct {
+	Columns []query.Expression
+}
+
+func (p *PartitionByClause) ApplyWindow(spec *query.WindowSpec) {
+	spec.PartitionBy = p.Columns
+}
+
+// PartitionBy creates a PARTITION BY part for a window
+func PartitionBy(columns ...query.Expression) *PartitionByClause {
+	return &PartitionByClause{
+		Columns: columns,
+	}
+}
+
+func (o *OrderByClause) ApplyWindow(spec *query.WindowSpec) {
+	spec.OrderBy = o.OrderByClause
+}
+
+// FrameClause represents the frame of a window
+type FrameClause struct {
+	*query.WindowFrame
+}
+
+func (f *FrameClause) ApplyWindow(spec *query.WindowSpec) {
+	spec.Frame = f.WindowFrame
+}
+
+// Rows creates a ROWS BETWEEN start AND end frame
+func Rows(start, end query.FrameBound) *FrameClause {
+	return &FrameClause{
+		WindowFrame: &query.WindowFrame{Mode: query.FrameRows, Start: start, End: end},
+	}
+}
+
+// Range creates a RANGE BETWEEN start AND end frame
+func Range(start, end query.FrameBound) *FrameClause {
+	return &FrameClause{
+		WindowFrame: &query.WindowFrame{Mode: query.FrameRange, Start: start, End: end},
+	}
+}
+
+// Groups creates a GROUPS BETWEEN start AND end frame
+func Groups(start, end query.FrameBound) *FrameClause {
+	return &FrameClause{
+		WindowFrame: &query.WindowFrame{Mode: query.FrameGroups, Start: start, End: end},
+	}
+}
+
+// BaseWindow makes a window extend the named window
+type BaseWindow string
+
+func (b BaseWindow) ApplyWindow(spec *query.WindowSpec) {
+	spec.Name = string(b)
+}
+
+// NamedWindow represents a window definition of the WINDOW clause in SQLite
+type NamedWindow struct {
+	*query.NamedWindow
+}
+
+// ApplySelect implements the SelectPart interface
+func (n *NamedWindow) ApplySelect(stmt *SelectStmt) {
+	if stmt.windows == nil {
+		stmt.windows = &query.WindowClause{}
+	}
+	stmt.windows.Windows = append(stmt.windows.Windows, n.NamedWindow)
+}
+
+// Window creates a named window that window functions can use with OverWindow
+func Window(name string, parts ...WindowPart) *NamedWindow {
+	return &NamedWindow{
+		NamedWindow: &query.NamedWindow{
+			Name: name,
+			Spec: newWindowSpec(parts...),
+		},
+	}
+}
+
+// WindowFunction represents a SQLite window function
+type WindowFunction struct {
+	*query.WindowFunction
+}
+
+// ApplySelect implements the SelectPart interface
+func (wf *WindowFunction) ApplySelect(stmt *SelectStmt) {
+	if stmt.Columns == nil {
+		stmt.Columns = &SelectClause{
+			SelectClause: &query.SelectClause{},
+		}
+	}
+	stmt.Columns.Columns = append(stmt.Columns.Columns, wf.WindowFunction)
+}
+
+// Over sets the window the function is evaluated over
+func (wf *WindowFunction) Over(parts ...WindowPart) *WindowFunction {
+	wf.WindowFunction.Over = newWindowSpec(parts...)
+	return wf
+}
+
+// OverWindow evaluates the function over a window defined with Window
+func (wf *WindowFunction) OverWindow(name string) *WindowFunction {
+	wf.WindowFunction.Over = &query.WindowSpec{Name: name}
+	return wf
+}
+
+// As creates an alias for the window function
+func (wf *WindowFunction) As(name string) *WindowFunction {
+	wf.Alias = name
+	return wf
+}
+
+// Over turns the aggregation into a window function, e.g. a running total with Sum(...).Over(OrderBy(...))
+func (a *Aggregation) Over(parts ...WindowPart) *WindowFunction {
+	return &WindowFunction{
+		WindowFunction: a.Aggregation.Over(newWindowSpec(parts...)),
+	}
+}
+
+// RowNumber creates a ROW_NUMBER window function for SQLite
+func RowNumber(resultPtr *Integer) *WindowFunction {
+	return &WindowFunction{
+		WindowFunction: query.RowNumber(resultPtr),
+	}
+}
+
+// Rank creates a RANK window function for SQLite
+func Rank(resultPtr *Integer) *WindowFunction {
+	return &WindowFunction{
+		WindowFunction: query.Rank(resultPtr),
+	}
+}
+
+// DenseRank creates a DENSE_RANK window function for SQLite
+func DenseRank(resultPtr *Integer) *WindowFunction {
+	return &WindowFunction{
+		WindowFunction: query.DenseRank(resultPtr),
+	}
+}
+
+// Ntile creates an NTILE window function for SQLite
+func Ntile(n int, resultPtr *Integer) *WindowFunction {
+	return &WindowFunction{
+		WindowFunction: query.Ntile(n, resultPtr),
+	}
+}
+
+// Lag creates a LAG window function for SQLite
+func Lag(column schema.Column, offset int, resultPtr schema.Column) *WindowFunction {
+	return &WindowFunction{
+		WindowFunction: query.Lag(column, offset, resultPtr),
+	}
+}
+
+// Lead creates a LEAD window function for SQLite
+func Lead(column schema.Column, offset int, resultPtr schema.Column) *WindowFunction {
+	return &WindowFunction{
+		WindowFunction: query.Lead(column, offset, resultPtr),
+	}
+}
+
+// FirstValue creates a FIRST_VALUE window function for SQLite
+func FirstValue(column schema.Column, resultPtr schema.Column) *WindowFunction {
+	return &WindowFunction{
+		WindowFunction: query.FirstValue(column, resultPtr),
+	}
+}
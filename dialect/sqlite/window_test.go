@@ -0,0 +1,45 @@
+package sqlite
+
+import "testing"
+
+func TestWindowFunctions(t *testing.T) {
+	u := NewTable[testUser]()
+
+	// Aliased columns are only written with their alias in the select list
+	a := NewTable[testUser]()
+	a.Name.SetAlias("n")
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "row number over a partition",
+			stmt: Select(&u.Name, RowNumber(&Integer{}).Over(PartitionBy(&u.Age), OrderBy(Desc(&u.Score))).As("rn"), From(u)),
+			sql:  `SELECT "users"."name", ROW_NUMBER() OVER (PARTITION BY "users"."age" ORDER BY "users"."score" DESC) AS "rn" FROM "users"`,
+		},
+		{
+			name: "partition by an aliased column",
+			stmt: Select(&a.Name, Rank(&Integer{}).Over(PartitionBy(&a.Name), OrderBy(&a.ID)).As("r"), From(a)),
+			sql:  `SELECT "users"."name" AS "n", RANK() OVER (PARTITION BY "users"."name" ORDER BY "users"."id") AS "r" FROM "users"`,
+		},
+		{
+			name: "running total with a frame",
+			stmt: Select(&u.ID, Sum(&u.Score, &Float{}).Over(OrderBy(&u.ID), Rows(UnboundedPreceding, CurrentRow)).As("running"), From(u)),
+			sql:  `SELECT "users"."id", SUM("users"."score") OVER (ORDER BY "users"."id" ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) AS "running" FROM "users"`,
+		},
+		{
+			name: "named window",
+			stmt: Select(&u.ID, DenseRank(&Integer{}).OverWindow("w").As("dr"), From(u), Window("w", PartitionBy(&u.Age), OrderBy(&u.Score))),
+			sql:  `SELECT "users"."id", DENSE_RANK() OVER "w" AS "dr" FROM "users" WINDOW "w" AS (PARTITION BY "users"."age" ORDER BY "users"."score")`,
+		},
+		{
+			name: "lag with an offset",
+			stmt: Select(&u.ID, Lag(&u.Score, 1, &Float{}).Over(OrderBy(&u.ID)).As("previous"), From(u)),
+			sql:  `SELECT "users"."id", LAG("users"."score", ?) OVER (ORDER BY "users"."id") AS "previous" FROM "users"`,
+			args: []any{1},
+		},
+		{
+			name: "distinct aggregate over a window",
+			stmt: Select(Sum(&u.Score, &Float{}).Distinct().Over(OrderBy(&u.ID)).As("total"), From(u)),
+			err:  "DISTINCT is not supported for window function SUM",
+		},
+	})
+}
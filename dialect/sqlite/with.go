@@ -0,0 +1,59 @@
+package sqlite
+
+import (
+	"github.com/gogo-framework/db/internal/query"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// CommonTableExpr represents a common table expression in SQLite.
+// It's both a SelectPart that adds the expression to the WITH clause and a table that can be used in From and joins.
+type CommonTableExpr struct {
+	*query.CommonTableExpression
+}
+
+// ApplySelect implements the SelectPart interface
+func (c *CommonTableExpr) ApplySelect(stmt *SelectStmt) {
+	if stmt.with == nil {
+		stmt.with = &query.WithClause{}
+	}
+	stmt.with.CTEs = append(stmt.with.CTEs, c.CommonTableExpression)
+	if c.Recursive {
+		stmt.with.Recursive = true
+	}
+}
+
+// Column declares a typed column of the common table expression,
+// the column can then be selected and used in conditions like any other table column.
+func (c *CommonTableExpr) Column(name string, column schema.Column) *CommonTableExpr {
+	c.RegisterColumn(name, column)
+	return c
+}
+
+// As sets the statement of the common table expression.
+// It's used by recursive expressions, whose statement can only be built after their columns are declared.
+func (c *CommonTableExpr) As(stmt query.Expression) *CommonTableExpr {
+	c.Stmt = stmt
+	return c
+}
+
+// With creates a common table expression with the given name
+func With(name string, stmt query.Expression) *CommonTableExpr {
+	return &CommonTableExpr{
+		CommonTableExpression: &query.CommonTableExpression{
+			Name: name,
+			Stmt: stmt,
+		},
+	}
+}
+
+// WithRecursive creates a recursive common table expression with the given name.
+// The statement usually references the expression itself, so it's set with As once the columns are declared,
+// e.g. tree := WithRecursive("tree").Column("id", id) and then tree.As(Union(base, Select(..., From(tree)))).
+func WithRecursive(name string) *CommonTableExpr {
+	return &CommonTableExpr{
+		CommonTableExpression: &query.CommonTableExpression{
+			Name:      name,
+			Recursive: true,
+		},
+	}
+}
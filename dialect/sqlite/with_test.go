@@ -0,0 +1,64 @@
+package sqlite
+
+import "testing"
+
+func TestWith(t *testing.T) {
+	u := NewTable[testUser]()
+	e := Alias[testUser]("e")
+
+	adultID := &Integer{}
+	adults := With("adults", Select(&u.ID, From(u), Where(u.Age.Gte(18)))).Column("id", adultID)
+
+	// Walks down the management chain from the user with id 1
+	id := &Integer{}
+	chain := WithRecursive("chain").Column("id", id)
+	chain.As(Union(
+		Select(&u.ID, From(u), Where(u.ID.Eq(1))),
+		Select(&e.ID, From(e), InnerJoin(chain).On(Eq(&e.ManagerID, id))),
+	))
+
+	unused := With("unused", Select(&u.ID, From(u)))
+
+	runRenderTests(t, []renderTest{
+		{
+			name: "common table expression",
+			stmt: Select(adultID, adults, From(adults)),
+			sql:  `WITH "adults"("id") AS (SELECT "users"."id" FROM "users" WHERE "users"."age" >= ?) SELECT "adults"."id" FROM "adults"`,
+			args: []any{int64(18)},
+		},
+		{
+			name: "recursive common table expression",
+			stmt: Select(id, chain, From(chain)),
+			sql: `WITH RECURSIVE "chain"("id") AS (SELECT "users"."id" FROM "users" WHERE "users"."id" = ? ` +
+				`UNION SELECT "e"."id" FROM "users" AS "e" INNER JOIN "chain" ON "e"."manager_id" = "chain"."id") ` +
+				`SELECT "chain"."id" FROM "chain"`,
+			args: []any{int64(1)},
+		},
+		{
+			name: "common table expression in a subquery",
+			stmt: Select(&u.Name, adults, From(u), Where(In(&u.ID, Select(adultID, From(adults))))),
+			sql:  `WITH "adults"("id") AS (SELECT "users"."id" FROM "users" WHERE "users"."age" >= ?) SELECT "users"."name" FROM "users" WHERE "users"."id" IN (SELECT "adults"."id" FROM "adults")`,
+			args: []any{int64(18)},
+		},
+		{
+			name: "common table expression without WITH",
+			stmt: Select(adultID, From(adults)),
+			err:  "common table expression adults is used without being added to the WITH clause",
+		},
+		{
+			name: "joined common table expression without WITH",
+			stmt: Select(&u.Name, From(u), InnerJoin(adults).On(Eq(adultID, &u.ID))),
+			err:  "common table expression adults is used without being added to the WITH clause",
+		},
+		{
+			name: "recursive common table expression without statement",
+			stmt: Select(WithRecursive("empty"), &u.ID, From(u)),
+			err:  "no statement for common table expression empty",
+		},
+		{
+			name: "unused common table expression",
+			stmt: Select(&u.ID, unused, From(u)),
+			sql:  `WITH "unused" AS (SELECT "users"."id" FROM "users") SELECT "users"."id" FROM "users"`,
+		},
+	})
+}
@@ -4,11 +4,11 @@ import (
 	"fmt"
 
 	"github.com/gogo-framework/db/dialect/sqlite"
-	"github.com/gogo-framework/db/internal/schema"
 )
 
 // User represents a user in the database
 type User struct {
+	sqlite.BaseTable
 	ID        sqlite.Integer
 	Username  sqlite.Text
 	Email     sqlite.Text
@@ -17,16 +17,15 @@ type User struct {
 	CreatedAt sqlite.Text
 }
 
-// Table implements the Tabler interface
-func (u *User) Table() *schema.Table {
-	return sqlite.NewTable("users", func(t *schema.Table) {
-		t.RegisterColumn("id", &u.ID)
-		t.RegisterColumn("username", &u.Username)
-		t.RegisterColumn("email", &u.Email)
-		t.RegisterColumn("age", &u.Age)
-		t.RegisterColumn("score", &u.Score)
-		t.RegisterColumn("created_at", &u.CreatedAt)
-	})
+// ConfigureSchema configures the table name and registers the columns
+func (u *User) ConfigureSchema(ts *sqlite.TableSchema) {
+	ts.SetName("users")
+	ts.RegisterColumn("id", &u.ID)
+	ts.RegisterColumn("username", &u.Username)
+	ts.RegisterColumn("email", &u.Email)
+	ts.RegisterColumn("age", &u.Age)
+	ts.RegisterColumn("score", &u.Score)
+	ts.RegisterColumn("created_at", &u.CreatedAt)
 }
 
 // UserStats represents aggregated user statistics
@@ -37,7 +36,7 @@ type UserStats struct {
 }
 
 func main() {
-	user := schema.NewTable[User]()
+	user := sqlite.NewTable[User]()
 	query, args := sqlite.Select(
 		sqlite.Distinct(),
 		&user.ID, &user.Username, &user.Email, &user.Age, &user.Score, &user.CreatedAt,
@@ -64,7 +63,7 @@ func main() {
 	fmt.Println(args)
 
 	// New example with aggregation and function
-	userStats := schema.NewTable[UserStats]()
+	userStats := sqlite.NewTable[UserStats]()
 	query, args = sqlite.Select(
 		&userStats.ID,
 		&userStats.Username,
@@ -90,4 +89,20 @@ func main() {
 	fmt.Println(query)
 	fmt.Println("\nQuery Arguments:")
 	fmt.Println(args)
+
+	// Self-join with independently aliased instances, finding users that share an email address
+	u1 := sqlite.Alias[User]("u1")
+	u2 := sqlite.Alias[User]("u2")
+	query, args = sqlite.Select(
+		&u1.Username,
+		&u2.Username,
+		sqlite.From(u1),
+		sqlite.InnerJoin(u2).On(sqlite.Eq(&u1.Email, &u2.Email)),
+		sqlite.Where(sqlite.Lt(&u1.ID, &u2.ID)),
+	).ToSql()
+
+	fmt.Println("\nGenerated SQL Query with Self-Join:")
+	fmt.Println(query)
+	fmt.Println("\nQuery Arguments:")
+	fmt.Println(args)
 }
@@ -1,24 +1,24 @@
 package main
 
 import (
+	"context"
 	"database/sql/driver"
 	"fmt"
 	"log"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gogo-framework/db"
 	"github.com/gogo-framework/db/dialect/sqlite"
-	"github.com/gogo-framework/db/internal"
-	"github.com/gogo-framework/db/internal/schema"
 )
 
 // User represents a user in the system
 type User struct {
-	table *schema.Table
-	ID    sqlite.Integer
-	Name  sqlite.Text
-	Age   sqlite.Integer
-	Bio   sqlite.Text
-	Data  sqlite.Blob
+	sqlite.BaseTable
+	ID   sqlite.Integer
+	Name sqlite.Text
+	Age  sqlite.Integer
+	Bio  sqlite.Text
+	Data sqlite.Blob
 }
 
 // UserStats represents aggregated user statistics
@@ -30,26 +30,14 @@ type UserStats struct {
 	Count      sqlite.Integer
 }
 
-// Table implements the schema.Tabler interface
-func (u *User) Table() *schema.Table {
-	if u.table == nil {
-		u.table = sqlite.NewTable("users", func(t *schema.Table) {
-			t.RegisterColumn("id", &u.ID)
-			t.RegisterColumn("name", &u.Name)
-			t.RegisterColumn("age", &u.Age)
-			t.RegisterColumn("bio", &u.Bio)
-			t.RegisterColumn("data", &u.Data)
-		})
-	}
-	return u.table
-}
-
-// GetColumns returns the columns from a SelectStmt
-func GetColumns(stmt *sqlite.SelectStmt) []schema.Column {
-	if stmt.Columns == nil {
-		return nil
-	}
-	return stmt.Columns.Columns
+// ConfigureSchema configures the table name and registers the columns
+func (u *User) ConfigureSchema(ts *sqlite.TableSchema) {
+	ts.SetName("users")
+	ts.RegisterColumn("id", &u.ID)
+	ts.RegisterColumn("name", &u.Name)
+	ts.RegisterColumn("age", &u.Age)
+	ts.RegisterColumn("bio", &u.Bio)
+	ts.RegisterColumn("data", &u.Data)
 }
 
 // ConvertToDriverValues converts []any to []driver.Value
@@ -63,15 +51,18 @@ func ConvertToDriverValues(args []any) []driver.Value {
 
 func main() {
 	// Create a mock database connection
-	db, mock, err := sqlmock.New()
+	mockDB, mock, err := sqlmock.New()
 	if err != nil {
 		log.Fatal("Failed to create mock database:", err)
 	}
-	defer db.Close()
+	defer mockDB.Close()
+
+	ctx := context.Background()
+	conn := db.New(mockDB, &sqlite.SqliteDialect{})
 
 	// Create instances for query building
-	user := schema.NewTable[User]()
-	userStats := schema.NewTable[UserStats]()
+	user := sqlite.NewTable[User]()
+	userStats := sqlite.NewTable[UserStats]()
 
 	// Example 1: Query all users with uppercase names
 	selectStmt := sqlite.Select(
@@ -95,18 +86,8 @@ func main() {
 	// This will match any query - no regex pattern matching issues
 	mock.ExpectQuery(".*").WillReturnRows(rows)
 
-	// Execute the query
-	queryRows, err := db.Query(selectSQL, args...)
-	if err != nil {
-		log.Fatal("Failed to execute query:", err)
-	}
-	defer queryRows.Close()
-
-	// Get the columns from the select statement
-	columns := GetColumns(selectStmt)
-
-	// Map the results to User structs
-	users, err := internal.MapAll[*User](queryRows, columns, user)
+	// Execute the query and map every row into a new User
+	users, err := db.Collect(ctx, conn, selectStmt, user)
 	if err != nil {
 		log.Fatal("Failed to map results:", err)
 	}
@@ -137,18 +118,8 @@ func main() {
 
 	mock.ExpectQuery(".*").WillReturnRows(statsRows)
 
-	// Execute the stats query
-	statsQueryRows, err := db.Query(statsSQL, statsArgs...)
-	if err != nil {
-		log.Fatal("Failed to execute stats query:", err)
-	}
-	defer statsQueryRows.Close()
-
-	// Get the columns from the stats statement
-	statsColumns := GetColumns(statsStmt)
-
-	// Map the results to UserStats structs
-	stats, err := internal.MapAll[*UserStats](statsQueryRows, statsColumns, userStats)
+	// Execute the stats query and map the row into a new UserStats
+	stats, err := db.Collect(ctx, conn, statsStmt, userStats)
 	if err != nil {
 		log.Fatal("Failed to map stats results:", err)
 	}
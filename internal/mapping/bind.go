@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// Binder binds the result columns of a statement to the fields of new instances of a model.
+// The columns are selected from one instance, the binder finds the fields they point to once,
+// so every row can be mapped into a fresh instance instead of overwriting the same one.
+type Binder struct {
+	paths [][]int
+}
+
+// fieldKey identifies a field by its address and type, a struct and its first field share the same address
+type fieldKey struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+// NewBinder creates a binder for columns, which must be (or scan into) exported fields of the struct model points to
+func NewBinder(model any, columns []schema.Column) (*Binder, error) {
+	v := reflect.ValueOf(model)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("model must be a non-nil pointer to a struct, got %T", model)
+	}
+
+	fields := make(map[fieldKey][]int)
+	collectFields(v.Elem(), nil, nil, fields)
+
+	b := &Binder{paths: make([][]int, len(columns))}
+	for i, col := range columns {
+		target := ResultColumn(col)
+		tv := reflect.ValueOf(target)
+		if tv.Kind() != reflect.Pointer || tv.IsNil() {
+			return nil, fmt.Errorf("column %d is not a pointer to a field of %T", i, model)
+		}
+		path, ok := fields[fieldKey{addr: tv.Pointer(), typ: tv.Type()}]
+		if !ok {
+			return nil, fmt.Errorf("column %d is not an exported field of %T", i, model)
+		}
+		if _, ok := v.Elem().FieldByIndex(path).Addr().Interface().(schema.Column); !ok {
+			return nil, fmt.Errorf("field of column %d of %T is not a column", i, model)
+		}
+		b.paths[i] = path
+	}
+	return b, nil
+}
+
+// collectFields records the index path of every field of v, including the fields of nested structs.
+// Unexported fields are recorded with the path of their closest exported parent, so that a column
+// embedded in an exported field, e.g. the embedded base of a column type, is bound through that field.
+func collectFields(v reflect.Value, parent []int, exported []int, fields map[fieldKey][]int) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		path := append(append([]int(nil), parent...), i)
+
+		addr := field.Addr()
+		closest := exported
+		if addr.CanInterface() {
+			closest = path
+		}
+		if closest != nil {
+			key := fieldKey{addr: addr.Pointer(), typ: addr.Type()}
+			if _, ok := fields[key]; !ok {
+				fields[key] = closest
+			}
+		}
+		if field.Kind() == reflect.Struct {
+			collectFields(field, path, closest, fields)
+		}
+	}
+}
+
+// Bind returns the fields of instance that correspond to the columns the binder was created for.
+// instance must be a pointer to the same struct type as the model.
+func (b *Binder) Bind(instance any) []schema.Column {
+	v := reflect.ValueOf(instance).Elem()
+	columns := make([]schema.Column, len(b.paths))
+	for i, path := range b.paths {
+		columns[i] = v.FieldByIndex(path).Addr().Interface().(schema.Column)
+	}
+	return columns
+}
+
+// ResultColumn returns the column a selected column is scanned into.
+// For functions, aggregations and other expressions this is their result column, otherwise the column itself.
+func ResultColumn(col schema.Column) schema.Column {
+	if r, ok := col.(interface{ ResultColumn() schema.Column }); ok && r.ResultColumn() != nil {
+		return r.ResultColumn()
+	}
+	return col
+}
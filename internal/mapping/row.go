@@ -9,96 +9,163 @@ package internal
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/gogo-framework/db/internal/schema"
 )
 
+// RowMapper maps the rows of a single result set into columns.
+// The result columns are matched with the columns by name once, on the first row,
+// after which every row is scanned directly into the columns.
+//
+// By default columns that aren't in the result set are left untouched and result columns that aren't selected are ignored.
+// In strict mode a MappingError is returned instead, see Strict.
 type RowMapper struct {
 	columns []schema.Column
+	strict  bool
+	// plan holds the index of the column every result column is scanned into, -1 if it isn't mapped
+	plan []int
+	// dest is reused for every row to avoid allocations
+	dest []any
 }
 
 func NewRowMapper(columns []schema.Column) *RowMapper {
 	return &RowMapper{columns: columns}
 }
 
-func (rm *RowMapper) MapRow(rows *sql.Rows) error {
-	colNames, err := rows.Columns()
-	if err != nil {
-		return fmt.Errorf("failed to get column names: %w", err)
+// Strict makes the mapper return a MappingError when the result set doesn't match the columns exactly
+func (rm *RowMapper) Strict() *RowMapper {
+	rm.strict = true
+	return rm
+}
+
+// MappingError is returned by a strict RowMapper when the result set doesn't match the selected columns
+type MappingError struct {
+	// Missing holds the names of selected columns that aren't in the result set
+	Missing []string
+	// Extra holds the names of result columns that aren't selected
+	Extra []string
+	// Duplicate holds the names that occur more than once, e.g. "id" when joining two tables without aliases
+	Duplicate []string
+}
+
+func (e *MappingError) Error() string {
+	var problems []string
+	if len(e.Missing) > 0 {
+		problems = append(problems, "missing columns "+strings.Join(e.Missing, ", "))
+	}
+	if len(e.Extra) > 0 {
+		problems = append(problems, "extra columns "+strings.Join(e.Extra, ", "))
 	}
+	if len(e.Duplicate) > 0 {
+		problems = append(problems, "duplicate columns "+strings.Join(e.Duplicate, ", "))
+	}
+	return "result set doesn't match the selected columns: " + strings.Join(problems, "; ")
+}
+
+// discard is scanned into for result columns that aren't mapped
+type discard struct{}
 
-	values := make([]any, len(colNames))
-	for i := range values {
-		values[i] = new(any)
+func (discard) Scan(any) error {
+	return nil
+}
+
+// Prepare computes the plan from the result columns of rows, if not done yet.
+// It can be called before the first row, so that a strict mapper also reports an empty result set that doesn't match.
+// A name that occurs multiple times, e.g. "id" when joining two tables, is matched in order of occurrence.
+func (rm *RowMapper) Prepare(rows *sql.Rows) error {
+	if rm.plan != nil {
+		return nil
 	}
 
-	if err := rows.Scan(values...); err != nil {
-		return fmt.Errorf("failed to scan row: %w", err)
+	colNames, err := rows.Columns()
+	if err != nil {
+		return fmt.Errorf("failed to get column names: %w", err)
 	}
 
-	// Create a map of column names to their indices for faster lookup
-	colNameToIndex := make(map[string]int)
+	positions := make(map[string][]int, len(colNames))
 	for i, name := range colNames {
-		colNameToIndex[name] = i
+		positions[name] = append(positions[name], i)
 	}
 
-	// Process each column in our schema
-	for _, col := range rm.columns {
-		colName := col.GetName()
-		index, exists := colNameToIndex[colName]
-		if !exists {
+	plan := make([]int, len(colNames))
+	for i := range plan {
+		plan[i] = -1
+	}
+	var mappingErr MappingError
+	for i, col := range rm.columns {
+		name := schema.OutputName(col)
+		if len(positions[name]) == 0 {
+			mappingErr.Missing = append(mappingErr.Missing, name)
 			continue
 		}
+		plan[positions[name][0]] = i
+		positions[name] = positions[name][1:]
+	}
 
-		value := *(values[index].(*any))
-		if err := col.Scan(value); err != nil {
-			return fmt.Errorf("failed to scan column %s: %w", colName, err)
+	if rm.strict {
+		for i, name := range colNames {
+			if plan[i] < 0 {
+				mappingErr.Extra = append(mappingErr.Extra, name)
+			}
+		}
+		mappingErr.Duplicate = duplicates(colNames, rm.columns)
+		if len(mappingErr.Missing) > 0 || len(mappingErr.Extra) > 0 || len(mappingErr.Duplicate) > 0 {
+			return &mappingErr
 		}
 	}
 
+	rm.plan = plan
+	rm.dest = make([]any, len(colNames))
 	return nil
 }
 
-func MapAll[T schema.Tabler](rows *sql.Rows, columns []schema.Column, model T) ([]T, error) {
-	var result []T
+// MapRow scans the current row into the columns of the mapper
+func (rm *RowMapper) MapRow(rows *sql.Rows) error {
+	return rm.MapRowInto(rows, rm.columns)
+}
 
-	for rows.Next() {
-		// Create a copy of the model for each row
-		modelCopy := model
-		mapper := NewRowMapper(columns)
+// MapRowInto scans the current row into dest, the value of the i-th column of the mapper is scanned into dest[i].
+// This allows the columns of one instance to be mapped into the fields of another instance.
+func (rm *RowMapper) MapRowInto(rows *sql.Rows, dest []schema.Column) error {
+	if err := rm.Prepare(rows); err != nil {
+		return err
+	}
 
-		if err := mapper.MapRow(rows); err != nil {
-			return nil, err
+	for i, col := range rm.plan {
+		if col < 0 {
+			rm.dest[i] = discard{}
+			continue
 		}
-
-		result = append(result, modelCopy)
+		rm.dest[i] = dest[col]
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, err
+	if err := rows.Scan(rm.dest...); err != nil {
+		return fmt.Errorf("failed to scan row: %w", err)
 	}
-
-	return result, nil
+	return nil
 }
 
-func MapOne[T schema.Tabler](rows *sql.Rows, columns []schema.Column, model T) (*T, error) {
-	if !rows.Next() {
-		return nil, sql.ErrNoRows
-	}
-
-	mapper := NewRowMapper(columns)
-
-	if err := mapper.MapRow(rows); err != nil {
-		return nil, err
+// duplicates returns the names that occur more than once in the result columns or the selected columns.
+// Even though they are matched in order, a different order in the result set would silently swap their values.
+func duplicates(colNames []string, columns []schema.Column) []string {
+	var names []string
+	seen := make(map[string]bool)
+	reported := make(map[string]bool)
+	check := func(name string) {
+		if seen[name] && !reported[name] {
+			names = append(names, name)
+			reported[name] = true
+		}
+		seen[name] = true
 	}
 
-	if rows.Next() {
-		return &model, fmt.Errorf("multiple rows returned for single result query")
+	for _, name := range colNames {
+		check(name)
 	}
-
-	if err := rows.Err(); err != nil {
-		return nil, err
+	clear(seen)
+	for _, col := range columns {
+		check(schema.OutputName(col))
 	}
-
-	return &model, nil
+	return names
 }
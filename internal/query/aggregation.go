@@ -5,6 +5,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/gogo-framework/db/dialect"
 	"github.com/gogo-framework/db/internal/schema"
@@ -12,43 +13,76 @@ import (
 
 // Aggregation represents a SQL aggregation function
 type Aggregation struct {
-	Function  string
-	Column    schema.Column
-	Alias     string
-	table     *schema.Table
-	name      string
-	resultPtr schema.Column
+	Function     string
+	Column       schema.Column
+	Alias        string
+	Distinct     bool
+	table        schema.Table
+	tableSchema  *schema.TableSchema
+	columnSchema *schema.ColumnSchema
+	resultPtr    schema.Column
+}
+
+// GetColumnSchema returns the schema of the result column, the aggregation itself has none
+func (a *Aggregation) GetColumnSchema() *schema.ColumnSchema {
+	if a.resultPtr != nil {
+		return a.resultPtr.GetColumnSchema()
+	}
+	return a.columnSchema
+}
+
+// SetColumnSchema sets the schema of the column
+func (a *Aggregation) SetColumnSchema(cs *schema.ColumnSchema) {
+	a.columnSchema = cs
+}
+
+// GetTableSchema returns the schema of the table this column belongs to
+func (a *Aggregation) GetTableSchema() *schema.TableSchema {
+	return a.tableSchema
+}
+
+// SetTableSchema sets the schema of the table this column belongs to
+func (a *Aggregation) SetTableSchema(ts *schema.TableSchema) {
+	a.tableSchema = ts
 }
 
 // GetTable returns the table this column belongs to
-func (a *Aggregation) GetTable() *schema.Table {
+func (a *Aggregation) GetTable() schema.Table {
 	return a.table
 }
 
 // SetTable sets the table this column belongs to
-func (a *Aggregation) SetTable(table *schema.Table) {
+func (a *Aggregation) SetTable(table schema.Table) {
 	a.table = table
 }
 
-// GetName returns the name of this column
-func (a *Aggregation) GetName() string {
-	if a.Alias != "" {
-		return a.Alias
-	}
-	return a.name
+func (a *Aggregation) GetAlias() string {
+	return a.Alias
 }
 
-// SetName sets the name of this column
-func (a *Aggregation) SetName(name string) {
-	a.name = name
+func (a *Aggregation) SetAlias(alias string) {
+	a.Alias = alias
 }
 
-// GetType returns the SQL type of this column
-func (a *Aggregation) GetType() string {
-	if a.resultPtr != nil {
-		return a.resultPtr.GetType()
+// OutputName returns the name of the aggregation in the result set, see schema.OutputName.
+// It's the alias, or the name of the result column, or a name derived from the function
+// and the aggregated column (e.g. avg_age, count_distinct_name, count_all) if neither is set.
+func (a *Aggregation) OutputName() string {
+	if a.Alias != "" {
+		return a.Alias
+	}
+	if cs := a.GetColumnSchema(); cs != nil {
+		return cs.GetName()
 	}
-	return a.Column.GetType()
+
+	name := strings.ToLower(a.Function) + "_"
+	if a.Distinct {
+		name += "distinct_"
+	}
+	if _, ok := a.Column.(*StarColumn); ok {
+		return name + "all"
+	}
+	return name + schema.OutputName(a.Column)
 }
 
 // Scan implements the sql.Scanner interface
@@ -59,6 +93,11 @@ func (a *Aggregation) Scan(value any) error {
 	return a.Column.Scan(value)
 }
 
+// ResultColumn returns the column the result of the aggregation is scanned into
+func (a *Aggregation) ResultColumn() schema.Column {
+	return a.resultPtr
+}
+
 // Value implements the driver.Valuer interface
 func (a *Aggregation) Value() (driver.Value, error) {
 	if a.resultPtr != nil {
@@ -67,14 +106,38 @@ func (a *Aggregation) Value() (driver.Value, error) {
 	return a.Column.Value()
 }
 
-// WriteSql implements the Expression interface
+// WriteSql implements the Expression interface, the aggregation is aliased to its output name
 func (a *Aggregation) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	args, err := a.writeCall(ctx, w, d, argPos)
+	if err != nil {
+		return nil, err
+	}
+
+	w.Write([]byte(" AS " + d.QuoteIdentifier(a.OutputName())))
+
+	return args, nil
+}
+
+// Unaliased implements the Aliased interface
+func (a *Aggregation) Unaliased() Expression {
+	return expressionFunc(a.writeCall)
+}
+
+// writeCall writes the aggregation without alias
+func (a *Aggregation) writeCall(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	if _, ok := a.Column.(*StarColumn); ok && a.Distinct {
+		return nil, fmt.Errorf("DISTINCT can't be used with %s(*)", a.Function)
+	}
+
 	// Write the function name and opening parenthesis
 	w.Write([]byte(a.Function))
 	w.Write([]byte("("))
+	if a.Distinct {
+		w.Write([]byte("DISTINCT "))
+	}
 
 	// Write the column expression
-	args, err := a.Column.WriteSql(ctx, w, d, argPos)
+	args, err := unaliased(a.Column).WriteSql(ctx, w, d, argPos)
 	if err != nil {
 		return nil, fmt.Errorf("error writing aggregation column: %w", err)
 	}
@@ -82,12 +145,6 @@ func (a *Aggregation) WriteSql(ctx context.Context, w io.Writer, d dialect.Diale
 	// Write closing parenthesis
 	w.Write([]byte(")"))
 
-	// Write alias if provided
-	if a.Alias != "" {
-		w.Write([]byte(" AS "))
-		w.Write([]byte(d.QuoteIdentifier(a.Alias)))
-	}
-
 	return args, nil
 }
 
@@ -96,7 +153,6 @@ func Avg(column schema.Column, resultPtr schema.Column) *Aggregation {
 	return &Aggregation{
 		Function:  "AVG",
 		Column:    column,
-		Alias:     fmt.Sprintf("avg_%s", column.GetName()),
 		resultPtr: resultPtr,
 	}
 }
@@ -106,7 +162,6 @@ func Count(column schema.Column, resultPtr schema.Column) *Aggregation {
 	return &Aggregation{
 		Function:  "COUNT",
 		Column:    column,
-		Alias:     fmt.Sprintf("count_%s", column.GetName()),
 		resultPtr: resultPtr,
 	}
 }
@@ -114,9 +169,9 @@ func Count(column schema.Column, resultPtr schema.Column) *Aggregation {
 // CountDistinct creates a COUNT(DISTINCT) aggregation
 func CountDistinct(column schema.Column, resultPtr schema.Column) *Aggregation {
 	return &Aggregation{
-		Function:  "COUNT(DISTINCT ",
+		Function:  "COUNT",
 		Column:    column,
-		Alias:     fmt.Sprintf("count_distinct_%s", column.GetName()),
+		Distinct:  true,
 		resultPtr: resultPtr,
 	}
 }
@@ -126,7 +181,6 @@ func Sum(column schema.Column, resultPtr schema.Column) *Aggregation {
 	return &Aggregation{
 		Function:  "SUM",
 		Column:    column,
-		Alias:     fmt.Sprintf("sum_%s", column.GetName()),
 		resultPtr: resultPtr,
 	}
 }
@@ -136,7 +190,6 @@ func Min(column schema.Column, resultPtr schema.Column) *Aggregation {
 	return &Aggregation{
 		Function:  "MIN",
 		Column:    column,
-		Alias:     fmt.Sprintf("min_%s", column.GetName()),
 		resultPtr: resultPtr,
 	}
 }
@@ -146,7 +199,6 @@ func Max(column schema.Column, resultPtr schema.Column) *Aggregation {
 	return &Aggregation{
 		Function:  "MAX",
 		Column:    column,
-		Alias:     fmt.Sprintf("max_%s", column.GetName()),
 		resultPtr: resultPtr,
 	}
 }
@@ -156,7 +208,6 @@ func GroupConcat(column schema.Column, resultPtr schema.Column) *Aggregation {
 	return &Aggregation{
 		Function:  "GROUP_CONCAT",
 		Column:    column,
-		Alias:     fmt.Sprintf("group_concat_%s", column.GetName()),
 		resultPtr: resultPtr,
 	}
 }
@@ -166,7 +217,6 @@ func Total(column schema.Column, resultPtr schema.Column) *Aggregation {
 	return &Aggregation{
 		Function:  "TOTAL",
 		Column:    column,
-		Alias:     fmt.Sprintf("total_%s", column.GetName()),
 		resultPtr: resultPtr,
 	}
 }
@@ -174,22 +224,30 @@ func Total(column schema.Column, resultPtr schema.Column) *Aggregation {
 // StarColumn represents a * column in SQL
 type StarColumn struct{}
 
-func (s *StarColumn) GetTable() *schema.Table {
+func (s *StarColumn) GetColumnSchema() *schema.ColumnSchema {
 	return nil
 }
 
-func (s *StarColumn) SetTable(*schema.Table) {}
+func (s *StarColumn) SetColumnSchema(*schema.ColumnSchema) {}
 
-func (s *StarColumn) GetName() string {
-	return "*"
+func (s *StarColumn) GetTableSchema() *schema.TableSchema {
+	return nil
 }
 
-func (s *StarColumn) SetName(string) {}
+func (s *StarColumn) SetTableSchema(*schema.TableSchema) {}
 
-func (s *StarColumn) GetType() string {
+func (s *StarColumn) GetTable() schema.Table {
+	return nil
+}
+
+func (s *StarColumn) SetTable(schema.Table) {}
+
+func (s *StarColumn) GetAlias() string {
 	return ""
 }
 
+func (s *StarColumn) SetAlias(string) {}
+
 func (s *StarColumn) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
 	w.Write([]byte("*"))
 	return nil, nil
@@ -208,7 +266,6 @@ func CountAll(resultPtr schema.Column) *Aggregation {
 	return &Aggregation{
 		Function:  "COUNT",
 		Column:    &StarColumn{},
-		Alias:     "count_all",
 		resultPtr: resultPtr,
 	}
 }
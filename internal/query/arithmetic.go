@@ -0,0 +1,170 @@
+package query
+
+import (
+	"context"
+	"io"
+
+	"github.com/gogo-framework/db/dialect"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+const (
+	OpAdd      Operator = "+"
+	OpSubtract Operator = "-"
+	OpMultiply Operator = "*"
+	OpDivide   Operator = "/"
+	OpModulo   Operator = "%"
+	OpConcat   Operator = "||"
+
+	OpBitAnd     Operator = "&"
+	OpBitOr      Operator = "|"
+	OpShiftLeft  Operator = "<<"
+	OpShiftRight Operator = ">>"
+)
+
+// precedence returns the binding strength of an arithmetic operator, higher binds tighter
+func precedence(op Operator) int {
+	switch op {
+	case OpConcat:
+		return 3
+	case OpMultiply, OpDivide, OpModulo:
+		return 2
+	case OpBitAnd, OpBitOr, OpShiftLeft, OpShiftRight:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// ArithmeticExpression represents an arithmetic operation between two expressions (e.g., col + 1)
+type ArithmeticExpression struct {
+	Left  Expression
+	Op    Operator
+	Right Expression
+}
+
+func (e *ArithmeticExpression) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	var args []any
+
+	leftArgs, err := e.writeOperand(ctx, w, d, argPos, e.Left, false)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, leftArgs...)
+
+	w.Write([]byte(" " + string(e.Op) + " "))
+
+	rightArgs, err := e.writeOperand(ctx, w, d, argPos+len(args), e.Right, true)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, rightArgs...)
+
+	return args, nil
+}
+
+// writeOperand writes an operand, nested operations are parenthesised when they bind weaker than this operation.
+// Right operands are also parenthesised on equal precedence, since a - (b - c) is not (a - b) - c.
+func (e *ArithmeticExpression) writeOperand(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int, operand Expression, right bool) ([]any, error) {
+	nested, ok := asArithmetic(operand)
+	if !ok || precedence(nested.Op) > precedence(e.Op) || (!right && precedence(nested.Op) == precedence(e.Op)) {
+		return operand.WriteSql(ctx, w, d, argPos)
+	}
+
+	w.Write([]byte("("))
+	args, err := operand.WriteSql(ctx, w, d, argPos)
+	if err != nil {
+		return nil, err
+	}
+	w.Write([]byte(")"))
+	return args, nil
+}
+
+// unwrapArithmetic is promoted to the types of dialect packages that embed an ArithmeticExpression
+func (e *ArithmeticExpression) unwrapArithmetic() *ArithmeticExpression {
+	return e
+}
+
+// asArithmetic returns the arithmetic expression behind expr, if it is one
+func asArithmetic(expr Expression) (*ArithmeticExpression, bool) {
+	if a, ok := expr.(interface{ unwrapArithmetic() *ArithmeticExpression }); ok {
+		return a.unwrapArithmetic(), true
+	}
+	return nil, false
+}
+
+// Into makes the expression selectable, its result is scanned into resultPtr
+func (e *ArithmeticExpression) Into(resultPtr schema.Column) *ExpressionColumn {
+	return &ExpressionColumn{
+		Column: resultPtr,
+		Expr:   e,
+	}
+}
+
+func arithmetic[T any](left Expression, op Operator, value T) *ArithmeticExpression {
+	return &ArithmeticExpression{
+		Left:  unaliased(left),
+		Op:    op,
+		Right: toExpression(value),
+	}
+}
+
+// Add creates an addition expression, value can be another expression or a value that is bound as a parameter
+func Add[T any](left Expression, value T) *ArithmeticExpression {
+	return arithmetic(left, OpAdd, value)
+}
+
+// Sub creates a subtraction expression, value can be another expression or a value that is bound as a parameter
+func Sub[T any](left Expression, value T) *ArithmeticExpression {
+	return arithmetic(left, OpSubtract, value)
+}
+
+// Mul creates a multiplication expression, value can be another expression or a value that is bound as a parameter
+func Mul[T any](left Expression, value T) *ArithmeticExpression {
+	return arithmetic(left, OpMultiply, value)
+}
+
+// Div creates a division expression, value can be another expression or a value that is bound as a parameter
+func Div[T any](left Expression, value T) *ArithmeticExpression {
+	return arithmetic(left, OpDivide, value)
+}
+
+// Modulo creates a modulo (%) expression, value can be another expression or a value that is bound as a parameter.
+// It's not named Mod to avoid confusion with the MOD function.
+func Modulo[T any](left Expression, value T) *ArithmeticExpression {
+	return arithmetic(left, OpModulo, value)
+}
+
+// BitAnd creates a bitwise AND (&) expression, value can be another expression or a value that is bound as a parameter
+func BitAnd[T any](left Expression, value T) *ArithmeticExpression {
+	return arithmetic(left, OpBitAnd, value)
+}
+
+// BitOr creates a bitwise OR (|) expression, value can be another expression or a value that is bound as a parameter
+func BitOr[T any](left Expression, value T) *ArithmeticExpression {
+	return arithmetic(left, OpBitOr, value)
+}
+
+// ShiftLeft creates a left shift (<<) expression, value can be another expression or a value that is bound as a parameter
+func ShiftLeft[T any](left Expression, value T) *ArithmeticExpression {
+	return arithmetic(left, OpShiftLeft, value)
+}
+
+// ShiftRight creates a right shift (>>) expression, value can be another expression or a value that is bound as a parameter
+func ShiftRight[T any](left Expression, value T) *ArithmeticExpression {
+	return arithmetic(left, OpShiftRight, value)
+}
+
+// Concat creates a string concatenation (||) of the given values,
+// every value can be an expression or a value that is bound as a parameter.
+func Concat(left Expression, values ...any) *ArithmeticExpression {
+	expr := unaliased(left)
+	for _, value := range values {
+		expr = arithmetic(expr, OpConcat, value)
+	}
+	if concat, ok := asArithmetic(expr); ok && len(values) > 0 {
+		return concat
+	}
+	// A single value is concatenated with an empty string so the result is always a string expression
+	return arithmetic(expr, OpConcat, "")
+}
@@ -0,0 +1,88 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gogo-framework/db/dialect"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// WhenClause is a single WHEN condition THEN result branch of a CASE expression
+type WhenClause struct {
+	Condition Condition
+	Result    Expression
+}
+
+// CaseExpression represents a CASE WHEN ... THEN ... ELSE ... END expression.
+// It can be used anywhere an expression is expected, e.g. in ORDER BY, GROUP BY and conditions.
+type CaseExpression struct {
+	Whens []*WhenClause
+	Else  Expression
+}
+
+// When adds a branch, value can be an expression or a value that is bound as a parameter
+func (c *CaseExpression) When(condition Condition, value any) *CaseExpression {
+	c.Whens = append(c.Whens, &WhenClause{
+		Condition: condition,
+		Result:    toExpression(value),
+	})
+	return c
+}
+
+// Otherwise sets the ELSE result, value can be an expression or a value that is bound as a parameter
+func (c *CaseExpression) Otherwise(value any) *CaseExpression {
+	c.Else = toExpression(value)
+	return c
+}
+
+func (c *CaseExpression) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	if len(c.Whens) == 0 {
+		return nil, fmt.Errorf("CASE expression has no WHEN branches")
+	}
+
+	var args []any
+	w.Write([]byte("CASE"))
+
+	for _, when := range c.Whens {
+		w.Write([]byte(" WHEN "))
+		conditionArgs, err := when.Condition.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing WHEN condition: %w", err)
+		}
+		args = append(args, conditionArgs...)
+
+		w.Write([]byte(" THEN "))
+		resultArgs, err := when.Result.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing THEN result: %w", err)
+		}
+		args = append(args, resultArgs...)
+	}
+
+	if c.Else != nil {
+		w.Write([]byte(" ELSE "))
+		elseArgs, err := c.Else.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing ELSE result: %w", err)
+		}
+		args = append(args, elseArgs...)
+	}
+
+	w.Write([]byte(" END"))
+	return args, nil
+}
+
+// defaultName is the output name of a selected CASE expression without alias or named result column
+func (c *CaseExpression) defaultName() string {
+	return "case"
+}
+
+// Into makes the CASE expression selectable, its result is scanned into resultPtr
+func (c *CaseExpression) Into(resultPtr schema.Column) *ExpressionColumn {
+	return &ExpressionColumn{
+		Column: resultPtr,
+		Expr:   c,
+	}
+}
@@ -0,0 +1,10 @@
+package query
+
+type CompoundOperator string
+
+const (
+	CompoundUnion     CompoundOperator = "UNION"
+	CompoundUnionAll  CompoundOperator = "UNION ALL"
+	CompoundIntersect CompoundOperator = "INTERSECT"
+	CompoundExcept    CompoundOperator = "EXCEPT"
+)
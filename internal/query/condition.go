@@ -23,6 +23,8 @@ const (
 	OpGlob               Operator = "GLOB"
 	OpMatch              Operator = "MATCH"
 	OpRegexp             Operator = "REGEXP"
+	OpIsDistinctFrom     Operator = "IS DISTINCT FROM"
+	OpIsNotDistinctFrom  Operator = "IS NOT DISTINCT FROM"
 )
 
 // Condition is an interface for SQL conditions
@@ -86,6 +88,97 @@ func (c *OrCondition) WriteSql(ctx context.Context, w io.Writer, d dialect.Diale
 	return args, nil
 }
 
+// AndCondition represents a set of conditions joined by AND
+type AndCondition struct {
+	Conditions []Condition
+}
+
+func (c *AndCondition) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	if len(c.Conditions) == 0 {
+		return nil, nil
+	}
+
+	var args []any
+	w.Write([]byte("("))
+
+	for i, condition := range c.Conditions {
+		if i > 0 {
+			w.Write([]byte(" AND "))
+		}
+
+		conditionArgs, err := condition.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, conditionArgs...)
+	}
+
+	w.Write([]byte(")"))
+	return args, nil
+}
+
+// NotCondition represents the negation of a condition
+type NotCondition struct {
+	Condition Condition
+}
+
+func (c *NotCondition) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	// Groups of conditions are already parenthesised
+	switch c.Condition.(type) {
+	case *AndCondition, *OrCondition:
+		w.Write([]byte("NOT "))
+		return c.Condition.WriteSql(ctx, w, d, argPos)
+	}
+
+	w.Write([]byte("NOT ("))
+	args, err := c.Condition.WriteSql(ctx, w, d, argPos)
+	if err != nil {
+		return nil, err
+	}
+	w.Write([]byte(")"))
+	return args, nil
+}
+
+// BetweenCondition represents a BETWEEN or NOT BETWEEN clause
+type BetweenCondition struct {
+	Column Expression
+	Low    Expression
+	High   Expression
+	Not    bool
+}
+
+func (c *BetweenCondition) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	var args []any
+
+	columnArgs, err := c.Column.WriteSql(ctx, w, d, argPos)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, columnArgs...)
+
+	if c.Not {
+		w.Write([]byte(" NOT BETWEEN "))
+	} else {
+		w.Write([]byte(" BETWEEN "))
+	}
+
+	lowArgs, err := c.Low.WriteSql(ctx, w, d, argPos+len(args))
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, lowArgs...)
+
+	w.Write([]byte(" AND "))
+
+	highArgs, err := c.High.WriteSql(ctx, w, d, argPos+len(args))
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, highArgs...)
+
+	return args, nil
+}
+
 // InCondition represents an IN clause
 type InCondition struct {
 	Column Expression
@@ -102,6 +195,16 @@ func (c *InCondition) WriteSql(ctx context.Context, w io.Writer, d dialect.Diale
 	}
 	args = append(args, columnArgs...)
 
+	// A single subquery is the source of the values itself
+	if subquery, ok := singleSubquery(c.Values); ok {
+		w.Write([]byte(" IN "))
+		subqueryArgs, err := subquery.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, err
+		}
+		return append(args, subqueryArgs...), nil
+	}
+
 	// Write IN and the values
 	w.Write([]byte(" IN ("))
 	for i, value := range c.Values {
@@ -119,6 +222,15 @@ func (c *InCondition) WriteSql(ctx context.Context, w io.Writer, d dialect.Diale
 	return args, nil
 }
 
+// singleSubquery returns the subquery if it's the only value of an IN clause
+func singleSubquery(values []Expression) (*Subquery, bool) {
+	if len(values) != 1 {
+		return nil, false
+	}
+	subquery, ok := values[0].(*Subquery)
+	return subquery, ok
+}
+
 // NotLikeCondition represents a NOT LIKE clause
 type NotLikeCondition struct {
 	Column  Expression
@@ -160,6 +272,15 @@ func (c *NotInCondition) WriteSql(ctx context.Context, w io.Writer, d dialect.Di
 	}
 	args = append(args, columnArgs...)
 
+	if subquery, ok := singleSubquery(c.Values); ok {
+		w.Write([]byte(" NOT IN "))
+		subqueryArgs, err := subquery.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, err
+		}
+		return append(args, subqueryArgs...), nil
+	}
+
 	w.Write([]byte(" NOT IN ("))
 	for i, value := range c.Values {
 		valueArgs, err := value.WriteSql(ctx, w, d, argPos+len(args))
@@ -205,57 +326,60 @@ func (c *IsNotNullCondition) WriteSql(ctx context.Context, w io.Writer, d dialec
 }
 
 // Condition builder functions
+// The value can be any Expression (a column, function, subquery, ...), which is written as is,
+// so comparisons such as orders.customer_id = customers.id render identifiers instead of parameters.
+// Any other value is bound as a parameter.
 func Eq[T any](column Expression, value T) Condition {
 	return &BinaryCondition{
-		Left:  column,
+		Left:  unaliased(column),
 		Op:    OpEqual,
-		Right: NewLiteral(value),
+		Right: toExpression(value),
 	}
 }
 
 func Neq[T any](column Expression, value T) Condition {
 	return &BinaryCondition{
-		Left:  column,
+		Left:  unaliased(column),
 		Op:    OpNotEqual,
-		Right: NewLiteral(value),
+		Right: toExpression(value),
 	}
 }
 
 func Gt[T any](column Expression, value T) Condition {
 	return &BinaryCondition{
-		Left:  column,
+		Left:  unaliased(column),
 		Op:    OpGreaterThan,
-		Right: NewLiteral(value),
+		Right: toExpression(value),
 	}
 }
 
 func Gte[T any](column Expression, value T) Condition {
 	return &BinaryCondition{
-		Left:  column,
+		Left:  unaliased(column),
 		Op:    OpGreaterThanOrEqual,
-		Right: NewLiteral(value),
+		Right: toExpression(value),
 	}
 }
 
 func Lt[T any](column Expression, value T) Condition {
 	return &BinaryCondition{
-		Left:  column,
+		Left:  unaliased(column),
 		Op:    OpLessThan,
-		Right: NewLiteral(value),
+		Right: toExpression(value),
 	}
 }
 
 func Lte[T any](column Expression, value T) Condition {
 	return &BinaryCondition{
-		Left:  column,
+		Left:  unaliased(column),
 		Op:    OpLessThanOrEqual,
-		Right: NewLiteral(value),
+		Right: toExpression(value),
 	}
 }
 
 func Like(column Expression, pattern string) Condition {
 	return &BinaryCondition{
-		Left:  column,
+		Left:  unaliased(column),
 		Op:    OpLike,
 		Right: NewLiteral(pattern),
 	}
@@ -264,10 +388,10 @@ func Like(column Expression, pattern string) Condition {
 func In[T any](column Expression, values ...T) Condition {
 	literals := make([]Expression, len(values))
 	for i, v := range values {
-		literals[i] = NewLiteral(v)
+		literals[i] = toExpression(v)
 	}
 	return &InCondition{
-		Column: column,
+		Column: unaliased(column),
 		Values: literals,
 	}
 }
@@ -275,7 +399,7 @@ func In[T any](column Expression, values ...T) Condition {
 // NotLike creates a NOT LIKE condition
 func NotLike(column Expression, pattern string) Condition {
 	return &NotLikeCondition{
-		Column:  column,
+		Column:  unaliased(column),
 		Pattern: NewLiteral(pattern),
 	}
 }
@@ -284,10 +408,10 @@ func NotLike(column Expression, pattern string) Condition {
 func NotIn[T any](column Expression, values ...T) Condition {
 	literals := make([]Expression, len(values))
 	for i, v := range values {
-		literals[i] = NewLiteral(v)
+		literals[i] = toExpression(v)
 	}
 	return &NotInCondition{
-		Column: column,
+		Column: unaliased(column),
 		Values: literals,
 	}
 }
@@ -295,13 +419,91 @@ func NotIn[T any](column Expression, values ...T) Condition {
 // IsNull creates an IS NULL condition
 func IsNull(column Expression) Condition {
 	return &IsNullCondition{
-		Column: column,
+		Column: unaliased(column),
 	}
 }
 
 // IsNotNull creates an IS NOT NULL condition
 func IsNotNull(column Expression) Condition {
 	return &IsNotNullCondition{
-		Column: column,
+		Column: unaliased(column),
+	}
+}
+
+// And creates a parenthesised group of conditions joined by AND, e.g. to nest it inside Or
+func And(conditions ...Condition) Condition {
+	return &AndCondition{
+		Conditions: conditions,
+	}
+}
+
+// Not creates a NOT condition
+func Not(condition Condition) Condition {
+	return &NotCondition{
+		Condition: condition,
+	}
+}
+
+// Between creates a BETWEEN condition
+func Between[T any](column Expression, low, high T) Condition {
+	return &BetweenCondition{
+		Column: unaliased(column),
+		Low:    toExpression(low),
+		High:   toExpression(high),
+	}
+}
+
+// NotBetween creates a NOT BETWEEN condition
+func NotBetween[T any](column Expression, low, high T) Condition {
+	return &BetweenCondition{
+		Column: unaliased(column),
+		Low:    toExpression(low),
+		High:   toExpression(high),
+		Not:    true,
+	}
+}
+
+// IsDistinctFrom creates a null-safe inequality condition
+func IsDistinctFrom[T any](column Expression, value T) Condition {
+	return &BinaryCondition{
+		Left:  unaliased(column),
+		Op:    OpIsDistinctFrom,
+		Right: toExpression(value),
+	}
+}
+
+// IsNotDistinctFrom creates a null-safe equality condition
+func IsNotDistinctFrom[T any](column Expression, value T) Condition {
+	return &BinaryCondition{
+		Left:  unaliased(column),
+		Op:    OpIsNotDistinctFrom,
+		Right: toExpression(value),
+	}
+}
+
+// Glob creates a GLOB condition
+func Glob(column Expression, pattern string) Condition {
+	return &BinaryCondition{
+		Left:  unaliased(column),
+		Op:    OpGlob,
+		Right: NewLiteral(pattern),
+	}
+}
+
+// Match creates a MATCH condition
+func Match(column Expression, pattern string) Condition {
+	return &BinaryCondition{
+		Left:  unaliased(column),
+		Op:    OpMatch,
+		Right: NewLiteral(pattern),
+	}
+}
+
+// Regexp creates a REGEXP condition
+func Regexp(column Expression, pattern string) Condition {
+	return &BinaryCondition{
+		Left:  unaliased(column),
+		Op:    OpRegexp,
+		Right: NewLiteral(pattern),
 	}
 }
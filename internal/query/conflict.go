@@ -0,0 +1,73 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gogo-framework/db/dialect"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// OnConflictClause represents the upsert part of an INSERT statement
+type OnConflictClause struct {
+	Columns   []schema.Column
+	DoNothing bool
+	Set       *SetClause
+	Where     *WhereClause
+}
+
+// WriteSql writes the conflict clause through the dialect, followed by the assignments and the optional WHERE of the update branch
+func (c *OnConflictClause) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	names := make([]string, len(c.Columns))
+	for i, col := range c.Columns {
+		names[i] = col.GetColumnSchema().GetName()
+	}
+
+	w.Write([]byte(d.OnConflict(names, c.DoNothing)))
+	if c.DoNothing {
+		return nil, nil
+	}
+
+	if c.Set == nil {
+		return nil, fmt.Errorf("no columns to set on conflict")
+	}
+
+	var args []any
+	setArgs, err := c.Set.WriteSql(ctx, w, d, argPos)
+	if err != nil {
+		return nil, err
+	}
+	args = append(args, setArgs...)
+
+	if c.Where != nil && len(c.Where.Conditions) > 0 {
+		w.Write([]byte(" WHERE "))
+		whereArgs, err := c.Where.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, whereArgs...)
+	}
+
+	return args, nil
+}
+
+// ExcludedColumn references the value that was proposed for insertion of a column in an upsert.
+// It embeds the column, so it's a column of the same type T that is only written differently.
+type ExcludedColumn[T any] struct {
+	schema.Column
+}
+
+// WriteSql writes the reference to the excluded value, an alias of the column is never written
+func (e *ExcludedColumn[T]) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	_, err := w.Write([]byte(d.Excluded(e.GetColumnSchema().GetName())))
+	return nil, err
+}
+
+// Excluded creates a reference to the proposed value of the given column, T is the value type of the column
+func Excluded[T any, C interface {
+	schema.Column
+	Get() T
+}](column C) *ExcludedColumn[T] {
+	return &ExcludedColumn[T]{Column: column}
+}
@@ -2,22 +2,45 @@ package query
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"github.com/gogo-framework/db/dialect"
 )
 
-// DistinctClause represents a DISTINCT clause in a SELECT statement
+// DistinctClause represents a DISTINCT clause in a SELECT statement.
+// If OnExprs is set it's written as DISTINCT ON (...), which only some dialects support.
 type DistinctClause struct {
-	OnExpr Expression
+	OnExprs []Expression
 }
 
 func (dc *DistinctClause) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
-	w.Write([]byte("DISTINCT "))
-	return nil, nil
+	if len(dc.OnExprs) == 0 {
+		w.Write([]byte("DISTINCT "))
+		return nil, nil
+	}
+	if !d.SupportsDistinctOn() {
+		return nil, fmt.Errorf("DISTINCT ON is not supported by this dialect")
+	}
+
+	var args []any
+	w.Write([]byte("DISTINCT ON ("))
+	for i, expr := range dc.OnExprs {
+		if i > 0 {
+			w.Write([]byte(", "))
+		}
+		exprArgs, err := unaliased(expr).WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing DISTINCT ON expression: %w", err)
+		}
+		args = append(args, exprArgs...)
+	}
+	w.Write([]byte(") "))
+	return args, nil
 }
 
-func (dc *DistinctClause) On(expr Expression) *DistinctClause {
-	dc.OnExpr = expr
+// On restricts the DISTINCT to the given expressions, adding to the ones set before
+func (dc *DistinctClause) On(exprs ...Expression) *DistinctClause {
+	dc.OnExprs = append(dc.OnExprs, exprs...)
 	return dc
 }
@@ -5,9 +5,108 @@ import (
 	"io"
 
 	"github.com/gogo-framework/db/dialect"
+	"github.com/gogo-framework/db/internal/schema"
 )
 
 // Expression defines the interface for SQL generation
 type Expression interface {
 	WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error)
 }
+
+// Aliased is implemented by selectable expressions that write an alias (AS "name") after themselves.
+// Unaliased returns the expression without the alias, so it can be nested inside other expressions.
+type Aliased interface {
+	Unaliased() Expression
+}
+
+// columnReference is implemented by columns that can be written without their alias, see schema.BaseColumn
+type columnReference interface {
+	WriteReference(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error)
+}
+
+// unaliased returns the expression without its alias if it has one
+func unaliased(expr Expression) Expression {
+	if aliased, ok := expr.(Aliased); ok {
+		return aliased.Unaliased()
+	}
+	if col, ok := expr.(columnReference); ok {
+		return expressionFunc(col.WriteReference)
+	}
+	return expr
+}
+
+// toExpression returns value if it already is an Expression, otherwise it wraps it in a Literal.
+// Aliased expressions such as functions are returned without their alias,
+// statements are wrapped in a Subquery so they're written between parentheses.
+func toExpression[T any](value T) Expression {
+	if expr, ok := any(value).(Expression); ok {
+		if stmt, ok := expr.(Statement); ok {
+			return stmt.AsSubquery()
+		}
+		return unaliased(expr)
+	}
+	return NewLiteral(value)
+}
+
+// expressionFunc adapts a function with the signature of WriteSql to the Expression interface
+type expressionFunc func(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error)
+
+func (f expressionFunc) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	return f(ctx, w, d, argPos)
+}
+
+// ExpressionColumn is an expression in the select list whose result is scanned into a column.
+// The result column is embedded, so it is selected and scanned as if it were that column.
+type ExpressionColumn struct {
+	schema.Column
+	Expr  Expression
+	Alias string
+}
+
+func (e *ExpressionColumn) GetAlias() string {
+	return e.Alias
+}
+
+func (e *ExpressionColumn) SetAlias(alias string) {
+	e.Alias = alias
+}
+
+// ResultColumn returns the column the result of the expression is scanned into
+func (e *ExpressionColumn) ResultColumn() schema.Column {
+	return e.Column
+}
+
+// Unaliased implements the Aliased interface
+func (e *ExpressionColumn) Unaliased() Expression {
+	return e.Expr
+}
+
+// OutputName returns the name of the expression in the result set, see schema.OutputName.
+// It's the alias, or the name of the result column, or a name derived from the kind of expression
+// (case or expr) if neither is set, e.g. when the result is scanned into a column that isn't part of a table.
+func (e *ExpressionColumn) OutputName() string {
+	if e.Alias != "" {
+		return e.Alias
+	}
+	if e.Column != nil {
+		if cs := e.GetColumnSchema(); cs != nil {
+			return cs.GetName()
+		}
+	}
+	if named, ok := e.Expr.(interface{ defaultName() string }); ok {
+		return named.defaultName()
+	}
+	return "expr"
+}
+
+// WriteSql writes the expression followed by its alias, see OutputName
+func (e *ExpressionColumn) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	args, err := e.Expr.WriteSql(ctx, w, d, argPos)
+	if err != nil {
+		return nil, err
+	}
+
+	w.Write([]byte(" AS " + d.QuoteIdentifier(e.OutputName())))
+
+	return args, nil
+}
@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"fmt"
 	"io"
 
 	"github.com/gogo-framework/db/dialect"
@@ -10,9 +11,9 @@ import (
 
 // FromClause represents a FROM clause
 type FromClause struct {
-	Source schema.Tabler
+	Source schema.Table
 	Alias  string
-	Joins  []any // We have no type yet for joins.
+	Joins  []*JoinClause
 }
 
 func (f *FromClause) ApplySelect(stmt *SelectStmt) {
@@ -22,12 +23,11 @@ func (f *FromClause) ApplySelect(stmt *SelectStmt) {
 func (f *FromClause) As(alias string) {
 	f.Alias = alias
 	if f.Source != nil {
-		table := f.Source.Table()
-		table.Alias = alias
+		f.Source.SetAlias(alias)
 	}
 }
 
-func (f *FromClause) AppendJoins(joins ...any) {
+func (f *FromClause) AppendJoins(joins ...*JoinClause) {
 	f.Joins = append(f.Joins, joins...)
 }
 
@@ -35,16 +35,38 @@ func (f *FromClause) AppendJoins(joins ...any) {
 func (f *FromClause) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
 	var args []any
 
-	// Write the table name with proper quoting
-	if _, err := w.Write([]byte(d.QuoteIdentifier(f.Source.Table().Name))); err != nil {
+	if f.Source == nil {
+		return nil, fmt.Errorf("no source for FROM clause")
+	}
+
+	// Write the source, which is either a table or a subquery
+	sourceArgs, err := f.Source.WriteSql(ctx, w, d, argPos)
+	if err != nil {
 		return nil, err
 	}
+	args = append(args, sourceArgs...)
 
-	// Write the alias if it exists
-	if f.Alias != "" {
-		if _, err := w.Write([]byte(" AS " + d.QuoteIdentifier(f.Alias))); err != nil {
+	// Write the alias if it exists, either set on the clause or on the table instance itself
+	alias := f.Alias
+	if alias == "" {
+		alias = f.Source.GetAlias()
+	}
+	if alias != "" {
+		if _, err := w.Write([]byte(" AS " + d.QuoteIdentifier(alias))); err != nil {
+			return nil, err
+		}
+	}
+
+	// Write the joins
+	for _, join := range f.Joins {
+		if _, err := w.Write([]byte(" ")); err != nil {
+			return nil, err
+		}
+		joinArgs, err := join.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
 			return nil, err
 		}
+		args = append(args, joinArgs...)
 	}
 
 	return args, nil
@@ -5,6 +5,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/gogo-framework/db/dialect"
 	"github.com/gogo-framework/db/internal/schema"
@@ -12,46 +13,67 @@ import (
 
 // Function represents a SQL function call
 type Function struct {
-	Name      string
-	Arguments []schema.Column
-	Args      []any
-	Result    schema.Column
-	table     *schema.Table
-	name      string
+	Name         string
+	Arguments    []schema.Column
+	Args         []any
+	Result       schema.Column
+	Alias        string
+	table        schema.Table
+	tableSchema  *schema.TableSchema
+	columnSchema *schema.ColumnSchema
+}
+
+// GetColumnSchema returns the schema of the result column, the function itself has none
+func (f *Function) GetColumnSchema() *schema.ColumnSchema {
+	if f.Result != nil {
+		return f.Result.GetColumnSchema()
+	}
+	return f.columnSchema
+}
+
+// SetColumnSchema sets the schema of the column
+func (f *Function) SetColumnSchema(cs *schema.ColumnSchema) {
+	f.columnSchema = cs
+}
+
+// GetTableSchema returns the schema of the table this column belongs to
+func (f *Function) GetTableSchema() *schema.TableSchema {
+	return f.tableSchema
+}
+
+// SetTableSchema sets the schema of the table this column belongs to
+func (f *Function) SetTableSchema(ts *schema.TableSchema) {
+	f.tableSchema = ts
 }
 
 // GetTable returns the table this column belongs to
-func (f *Function) GetTable() *schema.Table {
+func (f *Function) GetTable() schema.Table {
 	return f.table
 }
 
 // SetTable sets the table this column belongs to
-func (f *Function) SetTable(table *schema.Table) {
+func (f *Function) SetTable(table schema.Table) {
 	f.table = table
 }
 
-// GetName returns the name of this column
-func (f *Function) GetName() string {
-	if f.Result != nil {
-		return f.Result.GetName()
-	}
-	return f.name
+func (f *Function) GetAlias() string {
+	return f.Alias
 }
 
-// SetName sets the name of this column
-func (f *Function) SetName(name string) {
-	f.name = name
+func (f *Function) SetAlias(alias string) {
+	f.Alias = alias
 }
 
-// GetType returns the SQL type of this column
-func (f *Function) GetType() string {
-	if f.Result != nil {
-		return f.Result.GetType()
+// OutputName returns the name of the function in the result set, see schema.OutputName.
+// It's the alias, or the name of the result column, or the lowercase function name if neither is set.
+func (f *Function) OutputName() string {
+	if f.Alias != "" {
+		return f.Alias
 	}
-	if len(f.Arguments) > 0 {
-		return f.Arguments[0].GetType()
+	if cs := f.GetColumnSchema(); cs != nil {
+		return cs.GetName()
 	}
-	return ""
+	return strings.ToLower(f.Name)
 }
 
 // Scan implements the sql.Scanner interface
@@ -65,6 +87,11 @@ func (f *Function) Scan(value any) error {
 	return nil
 }
 
+// ResultColumn returns the column the result of the function is scanned into
+func (f *Function) ResultColumn() schema.Column {
+	return f.Result
+}
+
 // Value implements the driver.Valuer interface
 func (f *Function) Value() (driver.Value, error) {
 	if f.Result != nil {
@@ -76,8 +103,25 @@ func (f *Function) Value() (driver.Value, error) {
 	return nil, nil
 }
 
-// WriteSql implements the Expression interface
+// WriteSql implements the Expression interface, the function is aliased to its output name
 func (f *Function) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	allArgs, err := f.writeCall(ctx, w, d, argPos)
+	if err != nil {
+		return nil, err
+	}
+
+	w.Write([]byte(" AS " + d.QuoteIdentifier(f.OutputName())))
+
+	return allArgs, nil
+}
+
+// Unaliased implements the Aliased interface
+func (f *Function) Unaliased() Expression {
+	return expressionFunc(f.writeCall)
+}
+
+// writeCall writes the function call without alias
+func (f *Function) writeCall(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
 	// Write the function name and opening parenthesis
 	w.Write([]byte(f.Name))
 	w.Write([]byte("("))
@@ -88,7 +132,7 @@ func (f *Function) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect,
 		if i > 0 {
 			w.Write([]byte(", "))
 		}
-		args, err := arg.WriteSql(ctx, w, d, argPos+len(allArgs))
+		args, err := unaliased(arg).WriteSql(ctx, w, d, argPos+len(allArgs))
 		if err != nil {
 			return nil, fmt.Errorf("error writing function argument: %w", err)
 		}
@@ -107,12 +151,6 @@ func (f *Function) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect,
 	// Write closing parenthesis
 	w.Write([]byte(")"))
 
-	// Write alias if we have a result column
-	if f.Result != nil {
-		w.Write([]byte(" AS "))
-		w.Write([]byte(d.QuoteIdentifier(f.Result.GetName())))
-	}
-
 	return allArgs, nil
 }
 
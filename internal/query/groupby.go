@@ -26,7 +26,7 @@ func (g *GroupByClause) WriteSql(ctx context.Context, w io.Writer, d dialect.Dia
 		if i > 0 {
 			w.Write([]byte(", "))
 		}
-		args, err := col.WriteSql(ctx, w, d, argPos+len(allArgs))
+		args, err := unaliased(col).WriteSql(ctx, w, d, argPos+len(allArgs))
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,85 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gogo-framework/db/dialect"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+type JoinType string
+
+const (
+	JoinInner JoinType = "INNER JOIN"
+	JoinLeft  JoinType = "LEFT JOIN"
+	JoinRight JoinType = "RIGHT JOIN"
+	JoinFull  JoinType = "FULL JOIN"
+	JoinCross JoinType = "CROSS JOIN"
+)
+
+// JoinClause represents a JOIN of a table onto the FROM clause
+type JoinClause struct {
+	Type       JoinType
+	Table      schema.Table
+	Alias      string
+	Conditions []Condition
+}
+
+// As sets the alias of the joined table, columns of the table are prefixed with this alias
+func (j *JoinClause) As(alias string) {
+	j.Alias = alias
+	if j.Table != nil {
+		j.Table.SetAlias(alias)
+	}
+}
+
+// On adds conditions to the ON part of the join, multiple conditions are joined by AND
+func (j *JoinClause) On(conditions ...Condition) {
+	j.Conditions = append(j.Conditions, conditions...)
+}
+
+func (j *JoinClause) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	if j.Table == nil {
+		return nil, fmt.Errorf("no table to join")
+	}
+	if j.Type == JoinCross && len(j.Conditions) > 0 {
+		return nil, fmt.Errorf("CROSS JOIN does not accept ON conditions")
+	}
+
+	var args []any
+
+	w.Write([]byte(string(j.Type) + " "))
+	tableArgs, err := j.Table.WriteSql(ctx, w, d, argPos)
+	if err != nil {
+		return nil, fmt.Errorf("error writing joined table: %w", err)
+	}
+	args = append(args, tableArgs...)
+
+	alias := j.Alias
+	if alias == "" {
+		alias = j.Table.GetAlias()
+	}
+	if alias != "" {
+		w.Write([]byte(" AS " + d.QuoteIdentifier(alias)))
+	}
+
+	if len(j.Conditions) == 0 {
+		return args, nil
+	}
+
+	w.Write([]byte(" ON "))
+	for i, condition := range j.Conditions {
+		if i > 0 {
+			w.Write([]byte(" AND "))
+		}
+		conditionArgs, err := condition.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing join condition: %w", err)
+		}
+		args = append(args, conditionArgs...)
+	}
+
+	return args, nil
+}
@@ -3,14 +3,18 @@ package query
 import (
 	"context"
 	"io"
+	"strconv"
 
 	"github.com/gogo-framework/db/dialect"
 )
 
-// LimitOffsetClause represents a LIMIT and OFFSET clause
+// LimitOffsetClause represents a LIMIT and OFFSET clause.
+// The values are bound as parameters, so the statement text doesn't change between pages,
+// unless Inline is set in which case they are written into the SQL as constants.
 type LimitOffsetClause struct {
 	Limit  *int
 	Offset *int
+	Inline bool
 }
 
 func (l *LimitOffsetClause) ApplySelect(stmt *SelectStmt) {
@@ -21,10 +25,42 @@ func (l *LimitOffsetClause) WriteSql(ctx context.Context, w io.Writer, d dialect
 	if l.Limit == nil && l.Offset == nil {
 		return nil, nil
 	}
-	if _, err := w.Write([]byte(d.LimitOffset(l.Limit, l.Offset))); err != nil {
+
+	var args []any
+	var limit, offset string
+	if l.Limit != nil {
+		limit, args = l.value(*l.Limit, d, argPos, args)
+	}
+	if l.Offset != nil {
+		offset, args = l.value(*l.Offset, d, argPos, args)
+	}
+
+	if _, err := w.Write([]byte(d.LimitOffset(limit, offset))); err != nil {
 		return nil, err
 	}
-	return nil, nil
+	return args, nil
+}
+
+// value renders n as a placeholder, adding it to args, or as a constant if the clause is inlined
+func (l *LimitOffsetClause) value(n int, d dialect.Dialect, argPos int, args []any) (string, []any) {
+	if l.Inline {
+		return strconv.Itoa(n), args
+	}
+	return d.Placeholder(argPos + len(args)), append(args, n)
+}
+
+// Merge returns a new clause combining the clause with other, values set in other take precedence.
+// This allows LIMIT and OFFSET to be given as separate parts, neither clause is modified.
+func (l *LimitOffsetClause) Merge(other *LimitOffsetClause) *LimitOffsetClause {
+	merged := *l
+	if other.Limit != nil {
+		merged.Limit = other.Limit
+	}
+	if other.Offset != nil {
+		merged.Offset = other.Offset
+	}
+	merged.Inline = l.Inline || other.Inline
+	return &merged
 }
 
 // LimitOffset creates a LIMIT and OFFSET clause
@@ -27,7 +27,7 @@ func (o *OrderByClause) WriteSql(ctx context.Context, w io.Writer, d dialect.Dia
 		if i > 0 {
 			w.Write([]byte(", "))
 		}
-		args, err := col.WriteSql(ctx, w, d, argPos)
+		args, err := unaliased(col).WriteSql(ctx, w, d, argPos)
 		if err != nil {
 			return nil, fmt.Errorf("error writing order by column: %w", err)
 		}
@@ -43,3 +43,92 @@ func OrderBy(columns ...Expression) SelectPart {
 		Columns: columns,
 	}
 }
+
+type SortDirection string
+
+const (
+	SortAsc  SortDirection = "ASC"
+	SortDesc SortDirection = "DESC"
+)
+
+type NullsOrder string
+
+const (
+	NullsFirst NullsOrder = "NULLS FIRST"
+	NullsLast  NullsOrder = "NULLS LAST"
+)
+
+// OrderTerm represents an expression of an ORDER BY clause with its sort direction and NULLS placement
+type OrderTerm struct {
+	Expr      Expression
+	Direction SortDirection
+	Nulls     NullsOrder
+}
+
+func (t *OrderTerm) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	args, err := unaliased(t.Expr).WriteSql(ctx, w, d, argPos)
+	if err != nil {
+		return nil, err
+	}
+	if t.Direction != "" {
+		w.Write([]byte(" " + string(t.Direction)))
+	}
+	if t.Nulls != "" {
+		w.Write([]byte(" " + string(t.Nulls)))
+	}
+	return args, nil
+}
+
+// orderTerm returns a new OrderTerm for expr, if expr already is an OrderTerm it's copied,
+// so e.g. Desc(term) doesn't change a term that's also used elsewhere
+func orderTerm(expr Expression) *OrderTerm {
+	if term, ok := expr.(*OrderTerm); ok {
+		copied := *term
+		return &copied
+	}
+	return &OrderTerm{Expr: expr}
+}
+
+// Asc sorts by the expression in ascending order
+func Asc(expr Expression) *OrderTerm {
+	term := orderTerm(expr)
+	term.Direction = SortAsc
+	return term
+}
+
+// Desc sorts by the expression in descending order
+func Desc(expr Expression) *OrderTerm {
+	term := orderTerm(expr)
+	term.Direction = SortDesc
+	return term
+}
+
+// WithNulls places NULL values first or last, expr can be a plain expression or the result of Asc or Desc
+func WithNulls(expr Expression, nulls NullsOrder) *OrderTerm {
+	term := orderTerm(expr)
+	term.Nulls = nulls
+	return term
+}
+
+// CollateExpression represents an expression with an explicit collating sequence, e.g. name COLLATE NOCASE
+type CollateExpression struct {
+	Expr      Expression
+	Collation string
+}
+
+func (c *CollateExpression) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	args, err := c.Expr.WriteSql(ctx, w, d, argPos)
+	if err != nil {
+		return nil, err
+	}
+	w.Write([]byte(" COLLATE " + d.QuoteIdentifier(c.Collation)))
+	return args, nil
+}
+
+// Collate applies the collating sequence to the expression
+func Collate(expr Expression, collation string) *CollateExpression {
+	return &CollateExpression{
+		Expr:      unaliased(expr),
+		Collation: collation,
+	}
+}
@@ -0,0 +1,63 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+
+	"github.com/gogo-framework/db/dialect"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// ReturningClause represents a RETURNING clause of an INSERT, UPDATE or DELETE statement
+type ReturningClause struct {
+	Columns []schema.Column
+}
+
+// WriteSql writes the returned columns, unqualified as they always refer to the modified table
+func (r *ReturningClause) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	if !d.SupportsReturning() {
+		return nil, fmt.Errorf("dialect does not support RETURNING")
+	}
+	if len(r.Columns) == 0 {
+		return nil, fmt.Errorf("no columns to return")
+	}
+
+	for i, col := range r.Columns {
+		if i > 0 {
+			w.Write([]byte(", "))
+		}
+		w.Write([]byte(d.QuoteIdentifier(col.GetColumnSchema().GetName())))
+		if alias := col.GetAlias(); alias != "" {
+			w.Write([]byte(" AS " + d.QuoteIdentifier(alias)))
+		}
+	}
+	return nil, nil
+}
+
+// Scan scans the returned row into the columns of the clause and closes rows.
+// Only a single row can be scanned, as the columns belong to one instance, more rows are an error.
+// SQLite doesn't guarantee that rows are returned in the order they were inserted, so the rows of a multi-row
+// statement can't be matched with the instances they were inserted from. Map them with db.Collect instead.
+// No row is returned e.g. when an upsert does nothing, in which case the columns are left untouched.
+func (r *ReturningClause) Scan(rows *sql.Rows) error {
+	defer rows.Close()
+
+	if !rows.Next() {
+		return rows.Err()
+	}
+
+	dest := make([]any, len(r.Columns))
+	for i, col := range r.Columns {
+		dest[i] = col
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return fmt.Errorf("failed to scan returned row: %w", err)
+	}
+
+	if rows.Next() {
+		return fmt.Errorf("multiple rows returned, only a single row can be scanned into the returned columns")
+	}
+	return rows.Err()
+}
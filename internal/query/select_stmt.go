@@ -100,7 +100,6 @@ func (s *SelectStmt) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialec
 
 	// Write LIMIT and OFFSET
 	if s.limitOffset != nil {
-		w.Write([]byte(" "))
 		limitOffsetArgs, err := s.limitOffset.WriteSql(ctx, w, d, argPos)
 		if err != nil {
 			return nil, fmt.Errorf("error writing limit/offset: %w", err)
@@ -0,0 +1,59 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gogo-framework/db/dialect"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// Assignment represents a single column = value assignment in a SET clause
+type Assignment struct {
+	Column schema.Column
+	Value  Expression
+}
+
+// WriteSql writes the assignment, the column is written unqualified as required by the SET clause
+func (a *Assignment) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	w.Write([]byte(d.QuoteIdentifier(a.Column.GetColumnSchema().GetName()) + " = "))
+	args, err := a.Value.WriteSql(ctx, w, d, argPos)
+	if err != nil {
+		return nil, fmt.Errorf("error writing value for column %s: %w", a.Column.GetColumnSchema().GetName(), err)
+	}
+	return args, nil
+}
+
+// SetClause represents the SET clause of an UPDATE statement
+type SetClause struct {
+	Assignments []*Assignment
+}
+
+func (s *SetClause) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	if len(s.Assignments) == 0 {
+		return nil, fmt.Errorf("no columns to set")
+	}
+
+	var allArgs []any
+	for i, assignment := range s.Assignments {
+		if i > 0 {
+			w.Write([]byte(", "))
+		}
+		args, err := assignment.WriteSql(ctx, w, d, argPos+len(allArgs))
+		if err != nil {
+			return nil, err
+		}
+		allArgs = append(allArgs, args...)
+	}
+	return allArgs, nil
+}
+
+// Set creates an assignment of value to column.
+// If value is an Expression (e.g. a column or an arithmetic expression) it is written as is, otherwise it is bound as a parameter.
+func Set[T any](column schema.Column, value T) *Assignment {
+	return &Assignment{
+		Column: column,
+		Value:  toExpression(value),
+	}
+}
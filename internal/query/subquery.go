@@ -0,0 +1,92 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gogo-framework/db/dialect"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// Subquery represents a statement that is used as an expression or as a derived table.
+// It implements both the Expression and the schema.Table interface and is always written between parentheses.
+type Subquery struct {
+	Stmt   Expression
+	schema *schema.TableSchema
+	alias  string
+}
+
+// NewSubquery creates a new subquery for the given statement
+func NewSubquery(stmt Expression) *Subquery {
+	return &Subquery{Stmt: stmt}
+}
+
+// Statement is implemented by statements that can be nested in another statement, such as SELECT statements,
+// and by subqueries themselves. A statement that is used as an operand is written as the subquery returned by AsSubquery.
+type Statement interface {
+	Expression
+	AsSubquery() *Subquery
+}
+
+// AsSubquery implements the Statement interface
+func (s *Subquery) AsSubquery() *Subquery {
+	return s
+}
+
+// RegisterColumn declares a column of the derived table.
+// The column is bound to the subquery, so it's written as "alias"."column" in the outer query.
+// The name must match the name of the column in the result of the statement.
+func (s *Subquery) RegisterColumn(name string, column schema.Column) {
+	s.GetTableSchema().RegisterColumn(name, column)
+	column.SetTable(s)
+}
+
+// GetTableSchema returns the schema of the derived table, which is named after the alias of the subquery
+func (s *Subquery) GetTableSchema() *schema.TableSchema {
+	if s.schema == nil {
+		s.schema = &schema.TableSchema{}
+	}
+	s.schema.SetName(s.alias)
+	return s.schema
+}
+
+func (s *Subquery) GetAlias() string {
+	return s.alias
+}
+
+func (s *Subquery) SetAlias(alias string) {
+	s.alias = alias
+}
+
+func (s *Subquery) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	if s.Stmt == nil {
+		return nil, fmt.Errorf("no statement for subquery")
+	}
+
+	w.Write([]byte("("))
+	args, err := s.Stmt.WriteSql(ctx, w, d, argPos)
+	if err != nil {
+		return nil, fmt.Errorf("error writing subquery: %w", err)
+	}
+	w.Write([]byte(")"))
+
+	return args, nil
+}
+
+// ExistsCondition represents an EXISTS (subquery) condition
+type ExistsCondition struct {
+	Subquery *Subquery
+}
+
+func (c *ExistsCondition) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	w.Write([]byte("EXISTS "))
+	return c.Subquery.WriteSql(ctx, w, d, argPos)
+}
+
+// Exists creates an EXISTS condition for the given subquery or statement
+func Exists(stmt Statement) Condition {
+	return &ExistsCondition{
+		Subquery: stmt.AsSubquery(),
+	}
+}
@@ -0,0 +1,70 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gogo-framework/db/dialect"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// ValuesClause represents the column list and VALUES rows of an INSERT statement
+type ValuesClause struct {
+	Rows [][]schema.Column
+}
+
+// AppendRow adds a row of columns to the VALUES clause
+func (v *ValuesClause) AppendRow(columns ...schema.Column) {
+	v.Rows = append(v.Rows, columns)
+}
+
+// WriteSql writes the quoted column list followed by the VALUES rows.
+// The column list is taken from the first row, every other row must contain the same columns in the same order.
+func (v *ValuesClause) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	if len(v.Rows) == 0 || len(v.Rows[0]) == 0 {
+		return nil, fmt.Errorf("no values to insert")
+	}
+
+	names := make([]string, len(v.Rows[0]))
+	for i, col := range v.Rows[0] {
+		names[i] = col.GetColumnSchema().GetName()
+	}
+
+	w.Write([]byte("("))
+	for i, name := range names {
+		if i > 0 {
+			w.Write([]byte(", "))
+		}
+		w.Write([]byte(d.QuoteIdentifier(name)))
+	}
+	w.Write([]byte(") VALUES "))
+
+	var args []any
+	for i, row := range v.Rows {
+		if len(row) != len(names) {
+			return nil, fmt.Errorf("row %d has %d values, expected %d", i, len(row), len(names))
+		}
+		if i > 0 {
+			w.Write([]byte(", "))
+		}
+		w.Write([]byte("("))
+		for j, col := range row {
+			if name := col.GetColumnSchema().GetName(); name != names[j] {
+				return nil, fmt.Errorf("row %d has column %s at position %d, expected %s", i, name, j, names[j])
+			}
+			value, err := col.Value()
+			if err != nil {
+				return nil, fmt.Errorf("error getting value of column %s: %w", names[j], err)
+			}
+			if j > 0 {
+				w.Write([]byte(", "))
+			}
+			w.Write([]byte(d.Placeholder(argPos + len(args))))
+			args = append(args, value)
+		}
+		w.Write([]byte(")"))
+	}
+
+	return args, nil
+}
@@ -0,0 +1,326 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gogo-framework/db/dialect"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+type FrameMode string
+
+const (
+	FrameRows   FrameMode = "ROWS"
+	FrameRange  FrameMode = "RANGE"
+	FrameGroups FrameMode = "GROUPS"
+)
+
+// FrameBound is the start or end of a window frame
+type FrameBound string
+
+const (
+	UnboundedPreceding FrameBound = "UNBOUNDED PRECEDING"
+	CurrentRow         FrameBound = "CURRENT ROW"
+	UnboundedFollowing FrameBound = "UNBOUNDED FOLLOWING"
+)
+
+// Preceding creates a frame bound n rows (or values/groups) before the current row
+func Preceding(n int) FrameBound {
+	return FrameBound(fmt.Sprintf("%d PRECEDING", n))
+}
+
+// Following creates a frame bound n rows (or values/groups) after the current row
+func Following(n int) FrameBound {
+	return FrameBound(fmt.Sprintf("%d FOLLOWING", n))
+}
+
+// WindowFrame represents the frame specification of a window
+type WindowFrame struct {
+	Mode  FrameMode
+	Start FrameBound
+	End   FrameBound
+}
+
+func (f *WindowFrame) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	w.Write([]byte(string(f.Mode) + " "))
+	if f.End == "" {
+		w.Write([]byte(string(f.Start)))
+		return nil, nil
+	}
+	w.Write([]byte("BETWEEN " + string(f.Start) + " AND " + string(f.End)))
+	return nil, nil
+}
+
+// WindowSpec represents the window definition of an OVER or WINDOW clause.
+// Name refers to a named window, which the other parts of the specification extend.
+type WindowSpec struct {
+	Name        string
+	PartitionBy []Expression
+	OrderBy     *OrderByClause
+	Frame       *WindowFrame
+}
+
+// isReference returns whether the specification only refers to a named window
+func (ws *WindowSpec) isReference() bool {
+	return ws.Name != "" && len(ws.PartitionBy) == 0 && ws.OrderBy == nil && ws.Frame == nil
+}
+
+// WriteSql writes the window specification, including the surrounding parentheses
+func (ws *WindowSpec) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	if ws.isReference() {
+		w.Write([]byte(d.QuoteIdentifier(ws.Name)))
+		return nil, nil
+	}
+
+	var args []any
+	var sep string
+	w.Write([]byte("("))
+
+	if ws.Name != "" {
+		w.Write([]byte(d.QuoteIdentifier(ws.Name)))
+		sep = " "
+	}
+
+	if len(ws.PartitionBy) > 0 {
+		w.Write([]byte(sep + "PARTITION BY "))
+		for i, expr := range ws.PartitionBy {
+			if i > 0 {
+				w.Write([]byte(", "))
+			}
+			exprArgs, err := unaliased(expr).WriteSql(ctx, w, d, argPos+len(args))
+			if err != nil {
+				return nil, fmt.Errorf("error writing partition by expression: %w", err)
+			}
+			args = append(args, exprArgs...)
+		}
+		sep = " "
+	}
+
+	if ws.OrderBy != nil {
+		w.Write([]byte(sep + "ORDER BY "))
+		orderArgs, err := ws.OrderBy.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, orderArgs...)
+		sep = " "
+	}
+
+	if ws.Frame != nil {
+		w.Write([]byte(sep))
+		frameArgs, err := ws.Frame.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, frameArgs...)
+	}
+
+	w.Write([]byte(")"))
+	return args, nil
+}
+
+// WindowFunction represents a function evaluated over a window, e.g. ROW_NUMBER() OVER (ORDER BY ...).
+// The result column is embedded, so the window function is selected and scanned as if it were that column.
+type WindowFunction struct {
+	schema.Column
+	Function  string
+	Arguments []Expression
+	Distinct  bool
+	Over      *WindowSpec
+	Alias     string
+}
+
+func (wf *WindowFunction) GetAlias() string {
+	return wf.Alias
+}
+
+func (wf *WindowFunction) SetAlias(alias string) {
+	wf.Alias = alias
+}
+
+// OutputName returns the name of the window function in the result set, see schema.OutputName.
+// It's the alias, or the name of the result column, or a name derived from the function
+// and its column argument (e.g. row_number, sum_score) if neither is set.
+func (wf *WindowFunction) OutputName() string {
+	if wf.Alias != "" {
+		return wf.Alias
+	}
+	if wf.Column != nil {
+		if cs := wf.GetColumnSchema(); cs != nil {
+			return cs.GetName()
+		}
+	}
+
+	name := strings.ToLower(wf.Function)
+	for _, arg := range wf.Arguments {
+		if col, ok := arg.(schema.Column); ok {
+			return name + "_" + schema.OutputName(col)
+		}
+	}
+	return name
+}
+
+// WriteSql writes FUNC(args) OVER (window) AS alias, see OutputName
+func (wf *WindowFunction) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	args, err := wf.writeCall(ctx, w, d, argPos)
+	if err != nil {
+		return nil, err
+	}
+
+	w.Write([]byte(" AS " + d.QuoteIdentifier(wf.OutputName())))
+
+	return args, nil
+}
+
+// ResultColumn returns the column the result of the window function is scanned into
+func (wf *WindowFunction) ResultColumn() schema.Column {
+	return wf.Column
+}
+
+// Unaliased implements the Aliased interface
+func (wf *WindowFunction) Unaliased() Expression {
+	return expressionFunc(wf.writeCall)
+}
+
+// writeCall writes FUNC(args) OVER (window) without alias
+func (wf *WindowFunction) writeCall(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	if wf.Over == nil {
+		return nil, fmt.Errorf("window function %s has no window", wf.Function)
+	}
+
+	// SQLite rejects DISTINCT in aggregate window functions, e.g. SUM(DISTINCT col) OVER (...)
+	if wf.Distinct {
+		return nil, fmt.Errorf("DISTINCT is not supported for window function %s", wf.Function)
+	}
+
+	var args []any
+	w.Write([]byte(wf.Function + "("))
+	for i, arg := range wf.Arguments {
+		if i > 0 {
+			w.Write([]byte(", "))
+		}
+		argArgs, err := unaliased(arg).WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing window function argument: %w", err)
+		}
+		args = append(args, argArgs...)
+	}
+	w.Write([]byte(") OVER "))
+
+	overArgs, err := wf.Over.WriteSql(ctx, w, d, argPos+len(args))
+	if err != nil {
+		return nil, fmt.Errorf("error writing window: %w", err)
+	}
+	args = append(args, overArgs...)
+
+	return args, nil
+}
+
+// NamedWindow is a window definition of the WINDOW clause
+type NamedWindow struct {
+	Name string
+	Spec *WindowSpec
+}
+
+// WindowClause represents the WINDOW clause of a SELECT statement
+type WindowClause struct {
+	Windows []*NamedWindow
+}
+
+func (wc *WindowClause) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	var args []any
+	for i, window := range wc.Windows {
+		if i > 0 {
+			w.Write([]byte(", "))
+		}
+		w.Write([]byte(d.QuoteIdentifier(window.Name) + " AS "))
+
+		// A named window is always written in parentheses, even if it only refers to another window
+		if window.Spec.isReference() {
+			w.Write([]byte("(" + d.QuoteIdentifier(window.Spec.Name) + ")"))
+			continue
+		}
+		specArgs, err := window.Spec.WriteSql(ctx, w, d, argPos+len(args))
+		if err != nil {
+			return nil, fmt.Errorf("error writing window %s: %w", window.Name, err)
+		}
+		args = append(args, specArgs...)
+	}
+	return args, nil
+}
+
+// Over turns the aggregation into a window function over the given window.
+// A DISTINCT aggregation can't be used as a window function, writing it returns an error.
+func (a *Aggregation) Over(spec *WindowSpec) *WindowFunction {
+	return &WindowFunction{
+		Column:    a.resultPtr,
+		Function:  a.Function,
+		Arguments: []Expression{a.Column},
+		Distinct:  a.Distinct,
+		Over:      spec,
+		Alias:     a.Alias,
+	}
+}
+
+// RowNumber creates a ROW_NUMBER window function
+func RowNumber(resultPtr schema.Column) *WindowFunction {
+	return &WindowFunction{
+		Column:   resultPtr,
+		Function: "ROW_NUMBER",
+	}
+}
+
+// Rank creates a RANK window function
+func Rank(resultPtr schema.Column) *WindowFunction {
+	return &WindowFunction{
+		Column:   resultPtr,
+		Function: "RANK",
+	}
+}
+
+// DenseRank creates a DENSE_RANK window function
+func DenseRank(resultPtr schema.Column) *WindowFunction {
+	return &WindowFunction{
+		Column:   resultPtr,
+		Function: "DENSE_RANK",
+	}
+}
+
+// Ntile creates an NTILE window function that divides the partition into n groups
+func Ntile(n int, resultPtr schema.Column) *WindowFunction {
+	return &WindowFunction{
+		Column:    resultPtr,
+		Function:  "NTILE",
+		Arguments: []Expression{NewLiteral(n)},
+	}
+}
+
+// Lag creates a LAG window function returning the value of column offset rows before the current row
+func Lag(column Expression, offset int, resultPtr schema.Column) *WindowFunction {
+	return &WindowFunction{
+		Column:    resultPtr,
+		Function:  "LAG",
+		Arguments: []Expression{column, NewLiteral(offset)},
+	}
+}
+
+// Lead creates a LEAD window function returning the value of column offset rows after the current row
+func Lead(column Expression, offset int, resultPtr schema.Column) *WindowFunction {
+	return &WindowFunction{
+		Column:    resultPtr,
+		Function:  "LEAD",
+		Arguments: []Expression{column, NewLiteral(offset)},
+	}
+}
+
+// FirstValue creates a FIRST_VALUE window function
+func FirstValue(column Expression, resultPtr schema.Column) *WindowFunction {
+	return &WindowFunction{
+		Column:    resultPtr,
+		Function:  "FIRST_VALUE",
+		Arguments: []Expression{column},
+	}
+}
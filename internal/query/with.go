@@ -0,0 +1,146 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/gogo-framework/db/dialect"
+	"github.com/gogo-framework/db/internal/schema"
+)
+
+// CommonTableExpression represents a named statement in a WITH clause.
+// It implements the schema.Table interface so it can be referenced in FROM and JOIN clauses.
+type CommonTableExpression struct {
+	Name      string
+	Stmt      Expression
+	Recursive bool
+	schema    *schema.TableSchema
+	alias     string
+}
+
+// RegisterColumn declares a column of the common table expression.
+// The column is bound to the expression, so it's written as "name"."column" in the rest of the query.
+func (c *CommonTableExpression) RegisterColumn(name string, column schema.Column) {
+	c.GetTableSchema().RegisterColumn(name, column)
+	column.SetTable(c)
+}
+
+func (c *CommonTableExpression) GetTableSchema() *schema.TableSchema {
+	if c.schema == nil {
+		c.schema = &schema.TableSchema{}
+		c.schema.SetName(c.Name)
+	}
+	return c.schema
+}
+
+func (c *CommonTableExpression) GetAlias() string {
+	return c.alias
+}
+
+func (c *CommonTableExpression) SetAlias(alias string) {
+	c.alias = alias
+}
+
+// WriteSql writes a reference to the common table expression, its definition is written by the WithClause.
+// The expression must be defined by the WITH clause of the statement or of an enclosing statement.
+func (c *CommonTableExpression) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	if !inScope(ctx, c) {
+		return nil, fmt.Errorf("common table expression %s is used without being added to the WITH clause", c.Name)
+	}
+	_, err := w.Write([]byte(d.QuoteIdentifier(c.Name)))
+	return nil, err
+}
+
+// writeDefinition writes name(columns) AS (stmt)
+func (c *CommonTableExpression) writeDefinition(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	if c.Stmt == nil {
+		return nil, fmt.Errorf("no statement for common table expression %s", c.Name)
+	}
+
+	w.Write([]byte(d.QuoteIdentifier(c.Name)))
+	if columns := c.GetTableSchema().GetColumns(); len(columns) > 0 {
+		w.Write([]byte("("))
+		for i, col := range columns {
+			if i > 0 {
+				w.Write([]byte(", "))
+			}
+			w.Write([]byte(d.QuoteIdentifier(col.GetColumnSchema().GetName())))
+		}
+		w.Write([]byte(")"))
+	}
+
+	w.Write([]byte(" AS ("))
+	args, err := c.Stmt.WriteSql(ctx, w, d, argPos)
+	if err != nil {
+		return nil, fmt.Errorf("error writing common table expression %s: %w", c.Name, err)
+	}
+	w.Write([]byte(")"))
+
+	return args, nil
+}
+
+// cteScope links the common table expressions that can be referenced in the statement being written
+type cteScope struct {
+	ctes   []*CommonTableExpression
+	parent *cteScope
+}
+
+type cteScopeKey struct{}
+
+// inScope reports whether the common table expression is defined for the statement being written
+func inScope(ctx context.Context, c *CommonTableExpression) bool {
+	scope, _ := ctx.Value(cteScopeKey{}).(*cteScope)
+	for ; scope != nil; scope = scope.parent {
+		for _, cte := range scope.ctes {
+			if cte == c {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WithClause represents the WITH clause that prefixes a statement
+type WithClause struct {
+	Recursive bool
+	CTEs      []*CommonTableExpression
+}
+
+// Scope returns a context in which the common table expressions of the clause can be referenced.
+// Statements write the rest of their clauses with it, nested statements inherit it.
+func (wc *WithClause) Scope(ctx context.Context) context.Context {
+	if wc == nil || len(wc.CTEs) == 0 {
+		return ctx
+	}
+	parent, _ := ctx.Value(cteScopeKey{}).(*cteScope)
+	return context.WithValue(ctx, cteScopeKey{}, &cteScope{ctes: wc.CTEs, parent: parent})
+}
+
+func (wc *WithClause) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	if len(wc.CTEs) == 0 {
+		return nil, nil
+	}
+	// A recursive expression references itself
+	ctx = wc.Scope(ctx)
+
+	w.Write([]byte("WITH "))
+	if wc.Recursive {
+		w.Write([]byte("RECURSIVE "))
+	}
+
+	var allArgs []any
+	for i, cte := range wc.CTEs {
+		if i > 0 {
+			w.Write([]byte(", "))
+		}
+		args, err := cte.writeDefinition(ctx, w, d, argPos+len(allArgs))
+		if err != nil {
+			return nil, err
+		}
+		allArgs = append(allArgs, args...)
+	}
+	w.Write([]byte(" "))
+
+	return allArgs, nil
+}
@@ -50,6 +50,23 @@ type Column interface {
 	SetAlias(string)
 }
 
+// OutputName returns the name a selected column has in the result set.
+// It's used both to write the alias of the column in the select list and to map the result set back into the column,
+// so the two always agree. Expressions such as functions and aggregations provide it by implementing OutputName(),
+// for other columns it's their alias, or the name of the column if they aren't aliased.
+func OutputName(col Column) string {
+	if named, ok := col.(interface{ OutputName() string }); ok {
+		return named.OutputName()
+	}
+	if alias := col.GetAlias(); alias != "" {
+		return alias
+	}
+	if cs := col.GetColumnSchema(); cs != nil {
+		return cs.GetName()
+	}
+	return ""
+}
+
 // BaseColumn is a base implementation of the Column interface.
 type BaseColumn[T any] struct {
 	// tableSchema is the schema of the table that the column belongs to.
@@ -67,7 +84,11 @@ type BaseColumn[T any] struct {
 }
 
 func (bc *BaseColumn[T]) Scan(value any) error {
-	return bc.value.Scan(value)
+	if err := bc.value.Scan(value); err != nil {
+		return err
+	}
+	bc.scanned = true
+	return nil
 }
 
 func (bc *BaseColumn[T]) Value() (driver.Value, error) {
@@ -99,6 +120,22 @@ func (bc *BaseColumn[T]) SetTable(t Table) {
 }
 
 func (bc *BaseColumn[T]) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	if _, err := bc.WriteReference(ctx, w, d, argPos); err != nil {
+		return nil, err
+	}
+
+	if bc.alias != "" {
+		if _, err := io.WriteString(w, " AS "+d.QuoteIdentifier(bc.alias)); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// WriteReference writes the qualified name of the column without its alias.
+// The alias only applies in the select list, operands of conditions and expressions reference the column itself.
+func (bc *BaseColumn[T]) WriteReference(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
 	var sql strings.Builder
 
 	if bc.table != nil {
@@ -111,10 +148,6 @@ func (bc *BaseColumn[T]) WriteSql(ctx context.Context, w io.Writer, d dialect.Di
 
 	sql.WriteString(d.QuoteIdentifier(bc.columnSchema.name))
 
-	if bc.alias != "" {
-		sql.WriteString(" AS " + d.QuoteIdentifier(bc.alias))
-	}
-
 	_, err := io.WriteString(w, sql.String())
 	return nil, err
 }
@@ -144,6 +177,7 @@ func (bc *BaseColumn[T]) Get() T {
 
 func (bc *BaseColumn[T]) Set(value T) {
 	bc.value.V = value
+	bc.value.Valid = true
 	bc.scanned = true
 }
 
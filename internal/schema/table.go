@@ -29,6 +29,18 @@ func (ts *TableSchema) GetColumns() []Column {
 	return ts.columns
 }
 
+// SetName sets the name of the table, it should be called from ConfigureSchema.
+func (ts *TableSchema) SetName(name string) {
+	ts.name = name
+}
+
+// RegisterColumn registers a column of the table under the given name, it should be called from ConfigureSchema.
+func (ts *TableSchema) RegisterColumn(name string, column Column) {
+	column.SetColumnSchema(&ColumnSchema{name: name})
+	column.SetTableSchema(ts)
+	ts.columns = append(ts.columns, column)
+}
+
 // The Table interface is used within queries and is also the return type when mapping a row.
 type Table interface {
 	// GetTableSchema returns the schema of the table, the implementor should ensure that this happens only once.
@@ -72,3 +84,47 @@ func (t *BaseTable) GetAlias() string {
 func (t *BaseTable) SetAlias(alias string) {
 	t.alias = alias
 }
+
+func (t *BaseTable) WriteSql(ctx context.Context, w io.Writer, d dialect.Dialect, argPos int) ([]any, error) {
+	_, err := io.WriteString(w, d.QuoteIdentifier(t.GetTableSchema().GetName()))
+	return nil, err
+}
+
+func (t *BaseTable) setConfigurer(configurer TableConfigurer) {
+	t.TableConfigurer = configurer
+}
+
+// NewTable creates a new instance of the table type T, which must embed BaseTable.
+// The schema of the instance is configured and its columns are bound to the instance,
+// so every instance renders its columns with its own alias.
+func NewTable[T any, PT interface {
+	*T
+	Table
+	TableConfigurer
+}]() PT {
+	t := PT(new(T))
+	if b, ok := any(t).(interface{ setConfigurer(TableConfigurer) }); ok {
+		b.setConfigurer(t)
+	}
+	for _, col := range t.GetTableSchema().GetColumns() {
+		col.SetTable(t)
+	}
+	return t
+}
+
+// Alias creates a new, independently aliased instance of the table type T.
+// This allows the same table to be used multiple times in one query, e.g. for self-joins:
+//
+//	e := schema.Alias[Employee]("e")
+//	m := schema.Alias[Employee]("m")
+//
+// Columns of e are written as "e"."column" and columns of m as "m"."column".
+func Alias[T any, PT interface {
+	*T
+	Table
+	TableConfigurer
+}](alias string) PT {
+	t := NewTable[T, PT]()
+	t.SetAlias(alias)
+	return t
+}
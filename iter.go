@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"iter"
+
+	mapping "github.com/gogo-framework/db/internal/mapping"
+)
+
+// Iter executes the statement and returns an iterator over its rows, for results that are too large to collect.
+// Every row is scanned into the result columns, which must be bound to model, and model is yielded.
+// As the same instance is reused, values that must outlive an iteration have to be copied.
+//
+// The rows are closed when the iteration ends, also when the loop is stopped early.
+// The context is checked between rows, when it's done its error is yielded and the iteration stops.
+//
+//	for user, err := range db.Iter(ctx, conn, stmt, user) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(user.Name.Get())
+//	}
+func Iter[T any, PT tablePtr[T]](ctx context.Context, db *DB, stmt ResultStmt, model PT) iter.Seq2[PT, error] {
+	return func(yield func(PT, error) bool) {
+		// Only used to verify that the result columns are fields of model
+		if _, err := mapping.NewBinder(model, stmt.ResultColumns()); err != nil {
+			yield(nil, err)
+			return
+		}
+
+		rows, err := db.Query(ctx, stmt)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+			if err := rows.Scan(); err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(model, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
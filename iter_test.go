@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gogo-framework/db/dialect/sqlite"
+)
+
+func TestIter(t *testing.T) {
+	db, mock := newTestDB(t)
+	mock.ExpectQuery(selectUsers).
+		WithArgs(int64(0)).
+		WillReturnRows(userRows().AddRow(int64(1), "alice").AddRow(int64(2), "bob")).
+		RowsWillBeClosed()
+
+	model := sqlite.NewTable[testUser]()
+	var names []string
+	for user, err := range Iter(context.Background(), db, selectUsersStmt(model), model) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if user != model {
+			t.Fatal("expected the model to be yielded")
+		}
+		names = append(names, user.Name.Get())
+	}
+	if len(names) != 2 || names[0] != "alice" || names[1] != "bob" {
+		t.Errorf("got names %v, want [alice bob]", names)
+	}
+}
+
+func TestIterBreak(t *testing.T) {
+	db, mock := newTestDB(t)
+	// The expectation fails in the cleanup of newTestDB if the rows aren't closed after the break
+	mock.ExpectQuery(selectUsers).
+		WithArgs(int64(0)).
+		WillReturnRows(userRows().AddRow(int64(1), "alice").AddRow(int64(2), "bob").AddRow(int64(3), "carol")).
+		RowsWillBeClosed()
+
+	model := sqlite.NewTable[testUser]()
+	count := 0
+	for _, err := range Iter(context.Background(), db, selectUsersStmt(model), model) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		count++
+		break
+	}
+	if count != 1 {
+		t.Errorf("got %d iterations, want 1", count)
+	}
+	if model.ID.Get() != 1 {
+		t.Errorf("got id %d, want only the first row to be scanned", model.ID.Get())
+	}
+}
+
+func TestIterCanceled(t *testing.T) {
+	db, mock := newTestDB(t)
+	mock.ExpectQuery(selectUsers).
+		WithArgs(int64(0)).
+		WillReturnRows(userRows().AddRow(int64(1), "alice").AddRow(int64(2), "bob")).
+		RowsWillBeClosed()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	model := sqlite.NewTable[testUser]()
+	var errs []error
+	for _, err := range Iter(ctx, db, selectUsersStmt(model), model) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		cancel()
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], context.Canceled) {
+		t.Errorf("got errors %v, want only context.Canceled", errs)
+	}
+}